@@ -13,14 +13,18 @@ import (
 	"syscall"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/spirt-t/lg-operator/internal/ammo"
 	lgo "github.com/spirt-t/lg-operator/internal/app/api/lg-operator"
+	"github.com/spirt-t/lg-operator/internal/artifact"
 	"github.com/spirt-t/lg-operator/internal/cleaner"
 	"github.com/spirt-t/lg-operator/internal/config"
 	"github.com/spirt-t/lg-operator/internal/k8s"
 	"github.com/spirt-t/lg-operator/internal/logger"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -56,22 +60,65 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
 
-	k8sClient := k8s.NewClient(cfgManager, lg)
-	if err = k8sClient.Init(ctx); err != nil {
-		return fmt.Errorf("failed to make new k8s client: %w", err)
+	clusters, err := k8s.Clusters(cfgManager)
+	if err != nil {
+		return fmt.Errorf("failed to define k8s clusters: %w", err)
 	}
 
-	k8sManager, err := k8s.NewManager(k8sClient, cfgManager, lg)
-	if err != nil {
-		return fmt.Errorf("failed to make new k8s manager: %w", err)
+	var artifacts artifact.Store
+	if artifact.Enabled(cfgManager) {
+		artifacts, err = artifact.NewStore(ctx, cfgManager)
+		if err != nil {
+			return fmt.Errorf("failed to make artifact store: %w", err)
+		}
 	}
 
-	cleaners := []lgo.Cleaner{
-		cleaner.NewCompletedLGCleaner(cfgManager, k8sManager, lg),
-		cleaner.NewOutdatedLGCleaner(cfgManager, k8sManager, lg),
+	var (
+		cleaners = make([]lgo.Cleaner, 0, 3*len(clusters)+1)
+		managers = make(map[string]k8s.Manager, len(clusters))
+	)
+
+	// each cluster has its own client, manager and cleaners
+	for _, cluster := range clusters {
+		clusterLg := lg
+		if cluster.Name != "" {
+			clusterLg = lg.With(zap.String("cluster", cluster.Name))
+		}
+
+		clusterManager, err := newK8sManager(ctx, cluster.Config, artifacts, clusterLg)
+		if err != nil {
+			if cluster.Name != "" {
+				err = fmt.Errorf("cluster %s: %w", cluster.Name, err)
+			}
+
+			return err
+		}
+
+		managers[cluster.Name] = clusterManager
+		cleaners = append(cleaners,
+			cleaner.NewCompletedLGCleaner(cluster.Config, clusterManager, clusterLg),
+			cleaner.NewOutdatedLGCleaner(cluster.Config, clusterManager, clusterLg),
+			cleaner.NewExpiredLeaseCleaner(cluster.Config, clusterManager, clusterLg),
+		)
+	}
+
+	k8sManager := k8s.NewClusterManager(clusters, managers)
+
+	var ammoStorage *ammo.Storage
+	if ammo.Enabled(cfgManager) {
+		ammoStorage, err = ammo.NewStorageFromConfig(cfgManager)
+		if err != nil {
+			return fmt.Errorf("failed to make ammo storage: %w", err)
+		}
+
+		cleaners = append(cleaners, cleaner.NewUnusedAmmoCleaner(cfgManager, k8sManager, ammoStorage, lg))
 	}
 
-	service := lgo.NewService(k8sManager, cfgManager, lg, cleaners)
+	service := lgo.NewService(k8sManager, cfgManager, lg, lgo.ServiceOptions{
+		Cleaners:    cleaners,
+		Artifacts:   artifacts,
+		AmmoStorage: ammoStorage,
+	})
 	service.RunCleaning(ctx)
 
 	// serve
@@ -89,12 +136,32 @@ func run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to listen http port: %w", err)
 		}
-		return serveHTTP(gctx, listener, service)
+		return serveHTTP(gctx, listener, cfgManager)
 	})
 
 	return g.Wait()
 }
 
+// newK8sManager - manager of generators of cluster with config; it collects artifacts if store is set.
+func newK8sManager(ctx context.Context, cfgManager config.Manager, artifacts artifact.Store, lg *zap.Logger) (k8s.Manager, error) {
+	k8sClient := k8s.NewClient(cfgManager, lg)
+	if err := k8sClient.Init(ctx); err != nil {
+		return nil, fmt.Errorf("failed to make new k8s client: %w", err)
+	}
+
+	k8sManager, err := k8s.NewManager(k8sClient, cfgManager, lg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make new k8s manager: %w", err)
+	}
+
+	if artifacts != nil {
+		collector := artifact.NewCollector(cfgManager, k8sManager, artifacts, lg)
+		k8sManager = artifact.NewCollectingManager(k8sManager, collector, lg)
+	}
+
+	return k8sManager, nil
+}
+
 func listen(cfgManager config.Manager, portKey string) (net.Listener, error) {
 	var port int
 
@@ -113,10 +180,19 @@ func listen(cfgManager config.Manager, portKey string) (net.Listener, error) {
 	return listener, nil
 }
 
-func serveHTTP(ctx context.Context, listener net.Listener, service *lgo.Service) error {
+// serveHTTP serves grpc-gateway proxying requests to the grpc-server,
+// since in-process gateway handlers do not support streaming methods.
+func serveHTTP(ctx context.Context, listener net.Listener, cfgManager config.Manager) error {
+	var grpcPort int
+	if err := cfgManager.UnmarshalKey(grpcPortKey, &grpcPort); err != nil {
+		return fmt.Errorf("fail to define grpc port: %w", err)
+	}
+
 	mux := runtime.NewServeMux()
 
-	err := desc.RegisterLoadGeneratorOperatorServiceHandlerServer(ctx, mux, service)
+	err := desc.RegisterLoadGeneratorOperatorServiceHandlerFromEndpoint(ctx, mux, "localhost:"+strconv.Itoa(grpcPort), []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	})
 	if err != nil {
 		return fmt.Errorf("fail to register grpc-gateway handler: %w", err)
 	}
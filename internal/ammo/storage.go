@@ -0,0 +1,297 @@
+package ammo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	enabledKey = "ammo.enabled"
+	dirKey     = "ammo.dir"
+	maxSizeKey = "ammo.max_size"
+	volumeKey  = "ammo.volume"
+
+	defaultMaxSize = "1Gi"
+
+	// metaSuffix - metadata of ammo <id> is kept in <id>.json next to its file.
+	metaSuffix = ".json"
+)
+
+var (
+	// ErrNotFound - ammo does not exist in storage.
+	ErrNotFound = errors.New("ammo not found")
+	// ErrTooLarge - ammo exceeds maximum size.
+	ErrTooLarge = errors.New("ammo is too large")
+	// ErrChecksumMismatch - checksum of uploaded ammo differs from the declared one.
+	ErrChecksumMismatch = errors.New("ammo checksum mismatch")
+)
+
+// Storage - storage of ammo files in local directory of the operator, e.g. mount of PVC.
+// Generators mount the same files from volume of config, so it must share content of the directory.
+type Storage struct {
+	dir     string
+	maxSize int64
+	volume  k8s.AmmoVolume
+	// mu - guards metadata files.
+	mu sync.Mutex
+}
+
+// NewStorage constructor for Storage; the directory is created if it does not exist.
+func NewStorage(dir string, maxSize int64, volume k8s.AmmoVolume) (*Storage, error) {
+	if dir == "" {
+		return nil, errors.New("directory of ammo storage is not set")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("fail to create directory of ammo storage: %w", err)
+	}
+
+	return &Storage{dir: dir, maxSize: maxSize, volume: volume}, nil
+}
+
+// Enabled - ammo storage is turned on in config.
+func Enabled(config config.Manager) bool {
+	var enabled bool
+	_ = config.UnmarshalKey(enabledKey, &enabled)
+
+	return enabled
+}
+
+// NewStorageFromConfig - storage with parameters of config.
+func NewStorageFromConfig(config config.Manager) (*Storage, error) {
+	var (
+		dir, maxSizeStr string
+		volume          k8s.AmmoVolume
+	)
+
+	_ = config.UnmarshalKey(dirKey, &dir)
+	_ = config.UnmarshalKey(maxSizeKey, &maxSizeStr)
+
+	if err := config.UnmarshalKey(volumeKey, &volume); err != nil {
+		return nil, fmt.Errorf("fail to define ammo volume: %w", err)
+	}
+
+	if (volume.ClaimName == "") == (volume.HostPath == "") {
+		return nil, errors.New("exactly one of claim_name and host_path of ammo volume must be set")
+	}
+
+	if maxSizeStr == "" {
+		maxSizeStr = defaultMaxSize
+	}
+
+	maxSize, err := resource.ParseQuantity(maxSizeStr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse max size of ammo: %w", err)
+	}
+
+	return NewStorage(dir, maxSize.Value(), volume)
+}
+
+// Volume - volume generators mount ammo from.
+func (s *Storage) Volume() k8s.AmmoVolume {
+	return s.volume
+}
+
+// MaxSize - maximum size of ammo in bytes.
+func (s *Storage) MaxSize() int64 {
+	return s.maxSize
+}
+
+// Upload ammo read from r. The file is written to temporary file first and saved
+// only if it does not exceed maximum size and its checksum matches expectedSHA256, if it is set.
+func (s *Storage) Upload(name, expectedSHA256 string, r io.Reader) (model.Ammo, error) {
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return model.Ammo{}, fmt.Errorf("fail to create ammo file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(r, s.maxSize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return model.Ammo{}, fmt.Errorf("fail to write ammo: %w", err)
+	}
+
+	if size > s.maxSize {
+		return model.Ammo{}, fmt.Errorf("%w: maximum size is %d bytes", ErrTooLarge, s.maxSize)
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if expectedSHA256 != "" && !strings.EqualFold(expectedSHA256, checksum) {
+		return model.Ammo{}, fmt.Errorf("%w: declared %s, uploaded %s", ErrChecksumMismatch, expectedSHA256, checksum)
+	}
+
+	now := time.Now().UTC()
+	ammo := model.Ammo{
+		ID:        uuid.NewString(),
+		Name:      name,
+		Size:      size,
+		SHA256:    checksum,
+		CreatedAt: now,
+		UsedAt:    now,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.writeMeta(ammo); err != nil {
+		return model.Ammo{}, err
+	}
+
+	// temporary file is private, while containers of generators may run as other users
+	if err = os.Chmod(tmp.Name(), 0o644); err != nil {
+		_ = os.Remove(s.path(ammo.ID) + metaSuffix)
+		return model.Ammo{}, fmt.Errorf("fail to save ammo: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), s.path(ammo.ID)); err != nil {
+		_ = os.Remove(s.path(ammo.ID) + metaSuffix)
+		return model.Ammo{}, fmt.Errorf("fail to save ammo: %w", err)
+	}
+
+	return ammo, nil
+}
+
+// Get ammo by id.
+func (s *Storage) Get(id string) (model.Ammo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readMeta(id)
+}
+
+// Touch marks ammo as used now, so it is not collected as garbage.
+func (s *Storage) Touch(id string) (model.Ammo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ammo, err := s.readMeta(id)
+	if err != nil {
+		return model.Ammo{}, err
+	}
+
+	ammo.UsedAt = time.Now().UTC()
+
+	return ammo, s.writeMeta(ammo)
+}
+
+// List of all ammo ordered by upload time.
+func (s *Storage) List() ([]model.Ammo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read ammo storage: %w", err)
+	}
+
+	var list []model.Ammo
+	for _, entry := range entries {
+		id := strings.TrimSuffix(entry.Name(), metaSuffix)
+		if entry.IsDir() || id == entry.Name() {
+			continue
+		}
+
+		ammo, err := s.readMeta(id)
+		if err != nil {
+			continue
+		}
+
+		list = append(list, ammo)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+
+	return list, nil
+}
+
+// Delete ammo by id.
+func (s *Storage) Delete(id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("fail to delete ammo %s: %w", id, err)
+	}
+
+	if err := os.Remove(s.path(id) + metaSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("fail to delete ammo %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) path(id string) string {
+	return filepath.Join(s.dir, id)
+}
+
+func (s *Storage) readMeta(id string) (model.Ammo, error) {
+	if err := validateID(id); err != nil {
+		return model.Ammo{}, err
+	}
+
+	content, err := os.ReadFile(s.path(id) + metaSuffix)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Ammo{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+
+		return model.Ammo{}, fmt.Errorf("fail to read ammo %s: %w", id, err)
+	}
+
+	var ammo model.Ammo
+	if err = json.Unmarshal(content, &ammo); err != nil {
+		return model.Ammo{}, fmt.Errorf("fail to read ammo %s: %w", id, err)
+	}
+
+	return ammo, nil
+}
+
+func (s *Storage) writeMeta(ammo model.Ammo) error {
+	content, err := json.Marshal(ammo)
+	if err != nil {
+		return fmt.Errorf("fail to encode ammo: %w", err)
+	}
+
+	if err = os.WriteFile(s.path(ammo.ID)+metaSuffix, content, 0o644); err != nil {
+		return fmt.Errorf("fail to save ammo: %w", err)
+	}
+
+	return nil
+}
+
+// validateID - ammo ids are uuids, so they can not escape directory of storage.
+func validateID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+
+	return nil
+}
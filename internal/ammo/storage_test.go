@@ -0,0 +1,80 @@
+package ammo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	dir := t.TempDir()
+
+	storage, err := NewStorage(dir, 10, k8s.AmmoVolume{ClaimName: "ammo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sha256 of "ammo"
+	const checksum = "24d8a6183728f7cac3d037a13c7284323ade166db47acb0b5282f503939dbb63"
+
+	t.Run("upload", func(t *testing.T) {
+		ammo, err := storage.Upload("ammo.txt", "", strings.NewReader("ammo"))
+		assert.NoError(t, err)
+		assert.Equal(t, "ammo.txt", ammo.Name)
+		assert.Equal(t, int64(4), ammo.Size)
+		assert.Equal(t, checksum, ammo.SHA256)
+
+		content, err := os.ReadFile(filepath.Join(dir, ammo.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, "ammo", string(content))
+
+		// generators running as other users read ammo
+		info, err := os.Stat(filepath.Join(dir, ammo.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+
+		got, err := storage.Get(ammo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, ammo.SHA256, got.SHA256)
+
+		_, err = storage.Upload("ammo.txt", strings.ToUpper(checksum), strings.NewReader("ammo"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		_, err := storage.Upload("ammo.txt", checksum, strings.NewReader("other"))
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := storage.Upload("ammo.txt", "", strings.NewReader("too large ammo"))
+		assert.True(t, errors.Is(err, ErrTooLarge))
+	})
+
+	t.Run("list and delete", func(t *testing.T) {
+		list, err := storage.List()
+		assert.NoError(t, err)
+		if !assert.Len(t, list, 2) {
+			return
+		}
+
+		assert.NoError(t, storage.Delete(list[0].ID))
+
+		_, err = storage.Get(list[0].ID)
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, err := storage.Touch("../ammo")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
@@ -0,0 +1,114 @@
+package lg_operator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errAmmoDisabled = errors.New("ammo storage is disabled")
+	errInvalidAmmo  = errors.New("invalid ammo")
+)
+
+// UploadAmmo - save ammo received in chunks to storage of the operator.
+func (s *Service) UploadAmmo(stream desc.LoadGeneratorOperatorService_UploadAmmoServer) error {
+	if s.ammoStorage == nil {
+		return statusError(errAmmoDisabled)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry info of ammo")
+	}
+
+	if info.Size > s.ammoStorage.MaxSize() {
+		return status.Errorf(codes.InvalidArgument, "size of ammo %d exceeds maximum %d bytes", info.Size, s.ammoStorage.MaxSize())
+	}
+
+	ammo, err := s.ammoStorage.Upload(info.Name, info.Sha256, &chunkReader{stream: stream})
+	if err != nil {
+		return statusError(err)
+	}
+
+	s.logger.Info("ammo uploaded", zap.String("ammo_id", ammo.ID), zap.String("name", ammo.Name), zap.Int64("size", ammo.Size))
+
+	return stream.SendAndClose(&desc.UploadAmmoResponse{Ammo: AmmoMapper{}.ModelToPB(ammo)})
+}
+
+// chunkReader - content of ammo from chunks of upload stream.
+type chunkReader struct {
+	stream desc.LoadGeneratorOperatorService_UploadAmmoServer
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		in, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if in.GetInfo() != nil {
+			return 0, fmt.Errorf("%w: info of ammo is allowed only in the first message", errInvalidAmmo)
+		}
+
+		r.chunk = in.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
+
+// ammoMounts - ammo of creation parameters; every ammo must exist in storage.
+func (s *Service) ammoMounts(in *desc.CreateGeneratorsParams) ([]model.AmmoMount, error) {
+	if len(in.Ammo) == 0 {
+		return nil, nil
+	}
+
+	if s.ammoStorage == nil {
+		return nil, errAmmoDisabled
+	}
+
+	mounts := make([]model.AmmoMount, 0, len(in.Ammo))
+	for _, a := range in.Ammo {
+		if _, err := s.ammoStorage.Get(a.AmmoId); err != nil {
+			return nil, err
+		}
+
+		mounts = append(mounts, model.AmmoMount{ID: a.AmmoId, MountPath: a.MountPath})
+	}
+
+	return mounts, nil
+}
+
+// touchAmmo - mark ammo of created generator as used; ammo of dry runs and failed creations is not marked.
+func (s *Service) touchAmmo(ammo []model.AmmoMount) {
+	for _, a := range ammo {
+		if _, err := s.ammoStorage.Touch(a.ID); err != nil {
+			s.logger.Warn("fail to mark ammo as used", zap.String("ammo_id", a.ID), zap.Error(err))
+		}
+	}
+}
+
+// ammoVolume - volume of ammo storage mounted into generators.
+func (s *Service) ammoVolume() k8s.AmmoVolume {
+	if s.ammoStorage == nil {
+		return k8s.AmmoVolume{}
+	}
+
+	return s.ammoStorage.Volume()
+}
@@ -0,0 +1,175 @@
+package lg_operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/ammo"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type uploadStream struct {
+	grpc.ServerStream
+	in  []*desc.UploadAmmoRequest
+	out *desc.UploadAmmoResponse
+}
+
+func (us *uploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (us *uploadStream) Recv() (*desc.UploadAmmoRequest, error) {
+	if len(us.in) == 0 {
+		return nil, io.EOF
+	}
+
+	in := us.in[0]
+	us.in = us.in[1:]
+
+	return in, nil
+}
+
+func (us *uploadStream) SendAndClose(out *desc.UploadAmmoResponse) error {
+	us.out = out
+	return nil
+}
+
+func chunk(content string) *desc.UploadAmmoRequest {
+	return &desc.UploadAmmoRequest{Data: &desc.UploadAmmoRequest_Chunk{Chunk: []byte(content)}}
+}
+
+func info(info *desc.AmmoInfo) *desc.UploadAmmoRequest {
+	return &desc.UploadAmmoRequest{Data: &desc.UploadAmmoRequest_Info{Info: info}}
+}
+
+func TestService_Ammo(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage, err := ammo.NewStorage(t.TempDir(), 16, k8s.AmmoVolume{ClaimName: "ammo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{AmmoStorage: storage})
+
+	var ammoID string
+
+	t.Run("upload", func(t *testing.T) {
+		stream := &uploadStream{in: []*desc.UploadAmmoRequest{
+			info(&desc.AmmoInfo{Name: "ammo.txt"}), chunk("/ping\n"), chunk("/pong\n"),
+		}}
+
+		err := s.UploadAmmo(stream)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, "ammo.txt", stream.out.Ammo.Name)
+		assert.Equal(t, int64(12), stream.out.Ammo.Size)
+		ammoID = stream.out.Ammo.Id
+	})
+
+	t.Run("invalid upload", func(t *testing.T) {
+		for name, in := range map[string][]*desc.UploadAmmoRequest{
+			"without info":      {chunk("/ping")},
+			"repeated info":     {info(&desc.AmmoInfo{}), chunk("/ping"), info(&desc.AmmoInfo{})},
+			"too large":         {info(&desc.AmmoInfo{Size: 17})},
+			"too large content": {info(&desc.AmmoInfo{}), chunk("/ping/ping/ping/ping")},
+			"checksum mismatch": {info(&desc.AmmoInfo{Sha256: "0000"}), chunk("/ping")},
+		} {
+			err := s.UploadAmmo(&uploadStream{in: in})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+	})
+
+	t.Run("failed creation with ammo", func(t *testing.T) {
+		uploaded, err := storage.Get(ammoID)
+		assert.NoError(t, err)
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w: claim \"ammo\" of ammo volume not found", k8s.ErrInvalidArgument))
+
+		_, err = s.CreateGenerators(context.Background(), &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				Ammo:  []*desc.AmmoMount{{AmmoId: ammoID, MountPath: "/var/ammo/ammo.txt"}},
+			}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// failed creation does not extend retention of ammo
+		a, err := storage.Get(ammoID)
+		assert.NoError(t, err)
+		assert.Equal(t, uploaded.UsedAt, a.UsedAt)
+	})
+
+	t.Run("create with ammo", func(t *testing.T) {
+		uploaded, err := storage.Get(ammoID)
+		assert.NoError(t, err)
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, []model.AmmoMount{{ID: ammoID, MountPath: "/var/ammo/ammo.txt"}}, cfg.Ammo)
+				assert.Equal(t, k8s.AmmoVolume{ClaimName: "ammo"}, cfg.AmmoVolume)
+				return &model.LoadGenerator{Name: "testname"}, nil
+			})
+
+		_, err = s.CreateGenerators(context.Background(), &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				Ammo:  []*desc.AmmoMount{{AmmoId: ammoID, MountPath: "/var/ammo/ammo.txt"}},
+			}},
+		})
+		assert.NoError(t, err)
+
+		a, err := storage.Get(ammoID)
+		assert.NoError(t, err)
+		assert.True(t, a.UsedAt.After(uploaded.UsedAt))
+	})
+
+	t.Run("unknown ammo", func(t *testing.T) {
+		_, err := s.CreateGenerators(context.Background(), &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				Ammo:  []*desc.AmmoMount{{AmmoId: "unknown", MountPath: "/var/ammo/ammo.txt"}},
+			}},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+		err := s.UploadAmmo(&uploadStream{in: []*desc.UploadAmmoRequest{info(&desc.AmmoInfo{})}})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = s.CreateGenerators(context.Background(), &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				Ammo:  []*desc.AmmoMount{{AmmoId: ammoID, MountPath: "/var/ammo/ammo.txt"}},
+			}},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
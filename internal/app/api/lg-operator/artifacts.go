@@ -0,0 +1,75 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	artifactChunkSize = 64 * 1024
+)
+
+var (
+	errArtifactsDisabled = errors.New("collection of artifacts is disabled")
+)
+
+// ListArtifacts - get list of artifacts collected from generators.
+func (s *Service) ListArtifacts(ctx context.Context, in *desc.ListArtifactsRequest) (*desc.ListArtifactsResponse, error) {
+	if s.artifacts == nil {
+		return nil, statusError(errArtifactsDisabled)
+	}
+
+	artifacts, err := s.artifacts.List(ctx, in.GeneratorName)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.ListArtifactsResponse{Artifacts: ArtifactMapper{}.ModelToPBMany(artifacts)}, nil
+}
+
+// DownloadArtifact - stream archive of artifacts in chunks.
+func (s *Service) DownloadArtifact(in *desc.DownloadArtifactRequest, stream desc.LoadGeneratorOperatorService_DownloadArtifactServer) error {
+	if s.artifacts == nil {
+		return statusError(errArtifactsDisabled)
+	}
+
+	if in.GeneratorName == "" || in.Name == "" {
+		return status.Error(codes.InvalidArgument, "generator name and artifact name are required")
+	}
+
+	ctx := stream.Context()
+
+	archive, err := s.artifacts.Open(ctx, in.GeneratorName, in.Name)
+	if err != nil {
+		return statusError(err)
+	}
+	defer archive.Close()
+
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, err := archive.Read(buf)
+		if n > 0 {
+			if er := stream.Send(&desc.DownloadArtifactResponse{Chunk: buf[:n]}); er != nil {
+				return er
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				// client has gone away
+				return nil
+			}
+
+			return err
+		}
+	}
+}
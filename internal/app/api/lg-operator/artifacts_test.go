@@ -0,0 +1,92 @@
+package lg_operator
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/artifact"
+	"github.com/spirt-t/lg-operator/internal/config"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type downloadStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	content bytes.Buffer
+}
+
+func (ds *downloadStream) Context() context.Context {
+	return ds.ctx
+}
+
+func (ds *downloadStream) Send(out *desc.DownloadArtifactResponse) error {
+	ds.content.Write(out.Chunk)
+	return nil
+}
+
+func TestService_Artifacts(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store, err := artifact.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := strings.Repeat("archive", artifactChunkSize)
+	if _, err = store.Put(ctx, "generator-1", "first.tar.gz", strings.NewReader(archive)); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{Artifacts: store})
+
+	t.Run("list", func(t *testing.T) {
+		res, err := s.ListArtifacts(ctx, &desc.ListArtifactsRequest{GeneratorName: "generator-1"})
+		assert.NoError(t, err)
+		if assert.Len(t, res.Artifacts, 1) {
+			assert.Equal(t, "first.tar.gz", res.Artifacts[0].Name)
+			assert.Equal(t, int64(len(archive)), res.Artifacts[0].Size)
+		}
+	})
+
+	t.Run("download", func(t *testing.T) {
+		stream := &downloadStream{ctx: ctx}
+
+		err := s.DownloadArtifact(&desc.DownloadArtifactRequest{GeneratorName: "generator-1", Name: "first.tar.gz"}, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, archive, stream.content.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := s.DownloadArtifact(&desc.DownloadArtifactRequest{GeneratorName: "generator-1", Name: "unknown"}, &downloadStream{ctx: ctx})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+		res, err := disabled.ListArtifacts(ctx, &desc.ListArtifactsRequest{})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -3,12 +3,33 @@ package lg_operator
 import (
 	"context"
 
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// ClearAll - delete all generator's pods, services and ingresses.
-func (s *Service) ClearAll(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
-	err := s.k8s.DeleteAll(ctx)
+// ClearAll - delete all generator's pods, services and ingresses of namespace in all clusters managing it,
+// or of all managed namespaces if it is explicitly requested.
+func (s *Service) ClearAll(ctx context.Context, in *desc.ClearAllRequest) (*emptypb.Empty, error) {
+	namespace := in.Namespace
 
-	return &emptypb.Empty{}, err
+	switch {
+	case in.AllNamespaces && namespace != "":
+		return nil, status.Error(codes.InvalidArgument, "namespace must not be set to clear all namespaces")
+	case !in.AllNamespaces && namespace == "":
+		cluster, err := s.defaultCluster()
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		namespace = k8s.DefaultNamespace(cluster.Config)
+	}
+
+	if err := s.k8s.DeleteAll(ctx, namespace); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,58 @@
+package lg_operator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+var errUnknownCluster = errors.New("unknown cluster")
+
+// cluster - cluster of generator: requested one, the first cluster of requested region or the default cluster.
+func (s *Service) cluster(in *desc.CreateGeneratorsParams) (k8s.Cluster, error) {
+	clusters, err := k8s.Clusters(s.config)
+	if err != nil {
+		return k8s.Cluster{}, fmt.Errorf("fail to define clusters: %w", err)
+	}
+
+	switch {
+	case in.Cluster != "":
+		for _, cluster := range clusters {
+			if cluster.Name != "" && cluster.Name == in.Cluster {
+				return cluster, nil
+			}
+		}
+
+		return k8s.Cluster{}, fmt.Errorf("%w: %q", errUnknownCluster, in.Cluster)
+	case in.Region != "":
+		for _, cluster := range clusters {
+			if cluster.Region != "" && cluster.Region == in.Region {
+				return cluster, nil
+			}
+		}
+
+		return k8s.Cluster{}, fmt.Errorf("%w: no cluster in region %q", errUnknownCluster, in.Region)
+	}
+
+	return clusters[0], nil
+}
+
+// inCluster - service reading defaults of generators from config of cluster.
+func (s *Service) inCluster(cluster k8s.Cluster) *Service {
+	if cluster.Name == "" {
+		return s
+	}
+
+	clusterService := *s
+	clusterService.config = cluster.Config
+	clusterService.resourceMapper = NewResourceMapper(cluster.Config)
+
+	return &clusterService
+}
+
+// defaultCluster - cluster of generators created without cluster and region.
+func (s *Service) defaultCluster() (k8s.Cluster, error) {
+	return s.cluster(&desc.CreateGeneratorsParams{})
+}
@@ -0,0 +1,155 @@
+package lg_operator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const clustersConfig = `
+kubernetes:
+  namespace: default
+
+default_resources:
+  cpu:
+    request: 1
+    limit: 2
+  memory:
+    request: 1Gi
+    limit: 2Gi
+
+default_cluster: local
+
+clusters:
+  local:
+    region: europe
+  eu:
+    region: europe
+    kubernetes:
+      kubeconfig: /etc/lg-operator/eu.kubeconfig
+      namespace: perf-load
+    default_resources:
+      cpu:
+        request: 4
+        limit: 8
+      memory:
+        request: 4Gi
+        limit: 8Gi
+  us:
+    region: america
+`
+
+func newClustersConfig(t *testing.T) config.Manager {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(clustersConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mngr
+}
+
+func TestService_cluster(t *testing.T) {
+	s := &Service{config: newClustersConfig(t)}
+
+	tests := []struct {
+		name    string
+		in      *desc.CreateGeneratorsParams
+		want    string
+		wantErr bool
+	}{
+		{name: "default", in: &desc.CreateGeneratorsParams{}, want: "local"},
+		{name: "requested", in: &desc.CreateGeneratorsParams{Cluster: "eu"}, want: "eu"},
+		{name: "cluster over region", in: &desc.CreateGeneratorsParams{Cluster: "eu", Region: "america"}, want: "eu"},
+		{name: "region of default", in: &desc.CreateGeneratorsParams{Region: "europe"}, want: "local"},
+		{name: "region", in: &desc.CreateGeneratorsParams{Region: "america"}, want: "us"},
+		{name: "unknown cluster", in: &desc.CreateGeneratorsParams{Cluster: "asia"}, wantErr: true},
+		{name: "unknown region", in: &desc.CreateGeneratorsParams{Region: "asia"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster, err := s.cluster(tt.in)
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(statusError(err)))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, cluster.Name)
+		})
+	}
+
+	t.Run("no clusters", func(t *testing.T) {
+		mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := &Service{config: mngr}
+
+		cluster, err := s.cluster(&desc.CreateGeneratorsParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, "", cluster.Name)
+
+		_, err = s.cluster(&desc.CreateGeneratorsParams{Cluster: "eu"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(statusError(err)))
+	})
+}
+
+func TestService_creationConfig_cluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := NewService(mock_k8s.NewMockManager(ctrl), newClustersConfig(t), zaptest.NewLogger(t), ServiceOptions{})
+
+	t.Run("defaults of cluster", func(t *testing.T) {
+		cfg, err := s.creationConfig(&desc.CreateGeneratorsParams{Image: "testimage", Cluster: "eu"}, generatorMeta{})
+		assert.NoError(t, err)
+		assert.Equal(t, "eu", cfg.Cluster)
+		assert.Equal(t, model.Resources{
+			CPU:    model.Resource{Request: "4", Limit: "8"},
+			Memory: model.Resource{Request: "4Gi", Limit: "8Gi"},
+		}, cfg.Resources)
+	})
+
+	t.Run("global defaults", func(t *testing.T) {
+		cfg, err := s.creationConfig(&desc.CreateGeneratorsParams{Image: "testimage", Region: "america"}, generatorMeta{})
+		assert.NoError(t, err)
+		assert.Equal(t, "us", cfg.Cluster)
+		assert.Equal(t, model.Resources{
+			CPU:    model.Resource{Request: "1", Limit: "2"},
+			Memory: model.Resource{Request: "1Gi", Limit: "2Gi"},
+		}, cfg.Resources)
+	})
+}
+
+func TestService_ClearAll_cluster(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := NewService(k8sManager, newClustersConfig(t), zaptest.NewLogger(t), ServiceOptions{})
+
+	// the default cluster has the global namespace
+	k8sManager.EXPECT().DeleteAll(ctx, "default").Return(nil)
+
+	_, err := s.ClearAll(ctx, &desc.ClearAllRequest{})
+	assert.NoError(t, err)
+}
@@ -2,53 +2,570 @@ package lg_operator
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/spirt-t/lg-operator/internal/k8s"
 	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/spirt-t/lg-operator/internal/operation"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
-	"golang.org/x/sync/errgroup"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestHashLength - length of hash of creation request kept in annotation of generators.
+const requestHashLength = 16
+
+var (
+	errAllFailed  = errors.New("creation of all generators failed")
+	errInvalidTTL = errors.New("invalid ttl")
+	// errIdempotencyMismatch - idempotency key is reused with another creation request.
+	errIdempotencyMismatch = errors.New("idempotency key is used by another request")
 )
 
 // CreateGenerators ...
 func (s *Service) CreateGenerators(ctx context.Context, in *desc.CreateGeneratorsRequest) (*desc.CreateGeneratorsResponse, error) {
-	generators := make([]model.LoadGenerator, len(in.Parameters))
+	params, err := s.expandReplicas(in.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := creationOptions{mode: in.Mode, idempotencyKey: in.IdempotencyKey, spreadGroup: uuid.NewString()}
+
+	if in.DryRun {
+		rendered, err := s.renderGenerators(ctx, params, opts, in.RenderOptions)
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		return &desc.CreateGeneratorsResponse{RenderedGenerators: rendered}, nil
+	}
+
+	if in.IdempotencyKey != "" {
+		opts.requestHash, err = requestHash(in)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := s.k8s.ListByIdempotencyKey(ctx, in.IdempotencyKey)
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		opts.existing, err = existingGenerators(existing, opts.requestHash, len(params))
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		if len(opts.existing) == len(params) {
+			s.logger.Info("generators with idempotency key already exist", zap.String("idempotency_key", in.IdempotencyKey))
+			return createGeneratorsResponse(existingResults(existing)), nil
+		}
+
+		if len(opts.existing) > 0 {
+			s.logger.Info("generators with idempotency key are partially created; creating the rest",
+				zap.String("idempotency_key", in.IdempotencyKey), zap.Int("existing", len(opts.existing)))
+		}
+	}
+
+	opts.reservation, err = s.reserveQuota(ctx, params, opts.existing)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	if in.Async {
+		op := s.operations.Start(len(params), func(ctx context.Context, progress operation.ProgressFunc) error {
+			defer opts.reservation.releaseAll()
+			return s.runCreation(ctx, params, opts, progress)
+		})
+
+		return &desc.CreateGeneratorsResponse{OperationId: op.ID}, nil
+	}
+
+	defer opts.reservation.releaseAll()
+
+	results, err := s.createGenerators(ctx, params, opts, nil)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return createGeneratorsResponse(results), nil
+}
+
+// expandReplicas - repeat every parameters according to their replicas; the request may have at most
+// maxReplicas generators in total.
+// Request with invalid ttl, lease or ammo is rejected as a whole even in best-effort mode.
+func (s *Service) expandReplicas(params []*desc.CreateGeneratorsParams) ([]*desc.CreateGeneratorsParams, error) {
+	expanded := make([]*desc.CreateGeneratorsParams, 0, len(params))
+
+	for _, p := range params {
+		if _, err := s.ttl(p); err != nil {
+			return nil, statusError(err)
+		}
+
+		if _, err := lease(p); err != nil {
+			return nil, statusError(err)
+		}
+
+		if _, err := s.ammoMounts(p); err != nil {
+			return nil, statusError(err)
+		}
+
+		replicas := int(p.Replicas)
+		if replicas > s.maxReplicas {
+			return nil, status.Errorf(codes.InvalidArgument, "replicas %d exceed maximum %d", replicas, s.maxReplicas)
+		}
+
+		if replicas == 0 {
+			replicas = 1
+		}
+
+		// several parameters must not bypass the limit together
+		if total := len(expanded) + replicas; total > s.maxReplicas {
+			return nil, status.Errorf(codes.InvalidArgument, "request has at least %d generators, which exceeds maximum %d",
+				total, s.maxReplicas)
+		}
+
+		for i := 0; i < replicas; i++ {
+			expanded = append(expanded, p)
+		}
+	}
+
+	return expanded, nil
+}
+
+func createGeneratorsResponse(results []model.CreationResult) *desc.CreateGeneratorsResponse {
+	list := make([]model.LoadGenerator, 0, len(results))
+	for _, result := range results {
+		if result.Generator != nil {
+			list = append(list, *result.Generator)
+		}
+	}
+
+	return &desc.CreateGeneratorsResponse{
+		LoadGenerators: GeneratorMapper{}.ModelToPBMany(list),
+		Results:        CreationResultMapper{}.ModelToPBMany(results),
+	}
+}
 
-	g, ctxg := errgroup.WithContext(ctx)
-	for i, inParams := range in.Parameters {
+func existingResults(generators []model.LoadGenerator) []model.CreationResult {
+	results := make([]model.CreationResult, 0, len(generators))
+	for i := range generators {
+		results = append(results, model.CreationResult{Generator: &generators[i]})
+	}
+
+	return results
+}
+
+// requestHash - hash of creation request, except for its idempotency key and mode of response.
+func requestHash(in *desc.CreateGeneratorsRequest) (string, error) {
+	req := proto.Clone(in).(*desc.CreateGeneratorsRequest)
+	req.IdempotencyKey = ""
+	req.Async = false
+
+	content, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("fail to encode request: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])[:requestHashLength], nil
+}
+
+// existingGenerators - generators created with idempotency key by index of their parameters.
+// Generators of another request with the same key are rejected; generators created before request hashes
+// were kept are accepted.
+func existingGenerators(generators []model.LoadGenerator, hash string, count int) (map[int]*model.LoadGenerator, error) {
+	existing := make(map[int]*model.LoadGenerator, len(generators))
+
+	for i := range generators {
+		generator := &generators[i]
+
+		index := k8s.CreationIndex(generator.Name)
+		if (generator.RequestHash != "" && generator.RequestHash != hash) || index < 0 || index >= count {
+			return nil, fmt.Errorf("%w: generator %s", errIdempotencyMismatch, generator.Name)
+		}
+
+		existing[index] = generator
+	}
+
+	return existing, nil
+}
+
+// runCreation - body of asynchronous creation.
+// Best-effort operation fails only if none of generators has been created.
+func (s *Service) runCreation(
+	ctx context.Context,
+	params []*desc.CreateGeneratorsParams,
+	opts creationOptions,
+	progress operation.ProgressFunc) error {
+	results, err := s.createGenerators(ctx, params, opts, progress)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			return nil
+		}
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %v", errAllFailed, results[0].Err)
+}
+
+// createGenerators - create generators concurrently and return results in order of parameters.
+// At most creationConcurrency generators are created simultaneously.
+// In atomic mode creation of the others is cancelled on the first failure.
+// If ctx is cancelled or atomic creation fails, all created generators are deleted before return.
+func (s *Service) createGenerators(
+	ctx context.Context,
+	params []*desc.CreateGeneratorsParams,
+	opts creationOptions,
+	progress operation.ProgressFunc) ([]model.CreationResult, error) {
+	results := make([]model.CreationResult, len(params))
+
+	createCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		once    sync.Once
+		failure error
+		sem     = make(chan struct{}, s.creationConcurrency)
+	)
+
+	for i, inParams := range params {
 		params := inParams
 		i := i
-		g.Go(func() error {
-			generator, err := s.createGenerator(ctxg, params)
-			if err == nil {
-				generators[i] = *generator
+
+		if generator, ok := opts.existing[i]; ok {
+			// generator is created by the previous attempt of idempotent request
+			results[i].Generator = generator
+			if progress != nil {
+				progress(i, model.GeneratorProgress{Name: generator.Name, Stage: model.StageRunning, Generator: generator})
 			}
-			return err
-		})
+
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// created generator is listed by k8s, failed one is deleted, so its reservation is not needed anymore
+			defer opts.reservation.release(i)
+
+			var onProgress func(model.GeneratorProgress)
+			if progress != nil {
+				onProgress = func(p model.GeneratorProgress) { progress(i, p) }
+			}
+
+			generator, err := s.createGeneratorLimited(createCtx, sem, params, generatorMeta{opts, i}, onProgress)
+			if err != nil {
+				results[i].Err = err
+
+				if onProgress != nil {
+					stage := model.StageFailed
+					if createCtx.Err() != nil {
+						// k8s entities of unfinished generator are deleted by k8s manager
+						stage = model.StageRolledBack
+					}
+					onProgress(model.GeneratorProgress{Stage: stage, Message: err.Error()})
+				}
+
+				if opts.mode == desc.CreateGeneratorsRequest_ATOMIC {
+					once.Do(func() {
+						failure = err
+						cancel()
+					})
+				}
+
+				return
+			}
+
+			results[i].Generator = generator
+			if onProgress != nil {
+				onProgress(model.GeneratorProgress{Name: generator.Name, Stage: model.StageRunning, Generator: generator})
+			}
+		}()
 	}
-	if err := g.Wait(); err != nil {
-		return nil, err
+
+	wg.Wait()
+
+	err := ctx.Err()
+	if err == nil && failure != nil {
+		err = fmt.Errorf("fail to create generators: %w", failure)
 	}
 
-	return &desc.CreateGeneratorsResponse{
-		LoadGenerators: GeneratorMapper{}.ModelToPBMany(generators),
-	}, nil
+	if err == nil {
+		return results, nil
+	}
+
+	return nil, multierr.Append(err, s.rollback(results, progress))
+}
+
+// rollback - delete created generators; ctx of creation may be already cancelled, so it is not used.
+func (s *Service) rollback(results []model.CreationResult, progress operation.ProgressFunc) error {
+	var err error
+
+	for i, result := range results {
+		if result.Generator == nil {
+			continue
+		}
+
+		name := result.Generator.Name
+		if er := s.k8s.Delete(context.Background(), name); er != nil {
+			s.logger.Warn("fail to roll back generator", zap.Error(er), zap.String("generator_name", name))
+			err = multierr.Append(err, fmt.Errorf("fail to roll back generator %s: %w", name, er))
+
+			continue
+		}
+
+		if progress != nil {
+			progress(i, model.GeneratorProgress{Name: name, Stage: model.StageRolledBack})
+		}
+	}
+
+	return err
+}
+
+// runMeta - run the created generators belong to; empty for generators out of runs.
+type runMeta struct {
+	id   string
+	name string
+}
+
+// creationOptions - options of creation request applied to all its generators.
+type creationOptions struct {
+	mode           desc.CreateGeneratorsRequest_Mode
+	idempotencyKey string
+	// requestHash - hash of idempotent request kept by its generators.
+	requestHash string
+	// existing - generators of idempotent request created by its previous attempt, by index of parameters.
+	existing map[int]*model.LoadGenerator
+	run      runMeta
+	// spreadGroup - generators of the request are spread over nodes or zones within the group.
+	spreadGroup string
+	// reservation - quota reserved for generators of the request; nil if the request is not reserved.
+	reservation *quotaReservation
+}
+
+// generatorMeta - options of creation request and index of generator parameters in it.
+type generatorMeta struct {
+	creationOptions
+	index int
+}
+
+// createGeneratorLimited - create generator as soon as one of sem slots is free.
+func (s *Service) createGeneratorLimited(
+	ctx context.Context,
+	sem chan struct{},
+	in *desc.CreateGeneratorsParams,
+	meta generatorMeta,
+	progress func(model.GeneratorProgress)) (*model.LoadGenerator, error) {
+	// free slot is taken even if ctx is done, so the result does not depend on order of select cases
+	select {
+	case sem <- struct{}{}:
+	default:
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case sem <- struct{}{}:
+		}
+	}
+	defer func() { <-sem }()
+
+	return s.createGenerator(ctx, in, meta, progress)
 }
 
-func (s *Service) createGenerator(ctx context.Context, in *desc.CreateGeneratorsParams) (*model.LoadGenerator, error) {
-	resources, err := s.resourceMapper.PBToModel(in.Resources)
+func (s *Service) createGenerator(
+	ctx context.Context,
+	in *desc.CreateGeneratorsParams,
+	meta generatorMeta,
+	progress func(model.GeneratorProgress)) (*model.LoadGenerator, error) {
+	cfg, err := s.creationConfig(in, meta)
 	if err != nil {
 		return nil, err
 	}
 
-	envs := EnvVarMapper{}.PbToModelMany(in.AdditionalEnvs)
+	cfg.Progress = progress
 
-	generator, err := s.k8s.Create(ctx, k8s.CreationConfig{
-		Image:            in.Image,
+	generator, err := s.k8s.Create(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.touchAmmo(cfg.Ammo)
+
+	return generator, nil
+}
+
+// creationConfig - configuration of generator deploying with template and defaults resolved.
+func (s *Service) creationConfig(in *desc.CreateGeneratorsParams, meta generatorMeta) (k8s.CreationConfig, error) {
+	cluster, err := s.cluster(in)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	cfg, err := s.inCluster(cluster).clusterCreationConfig(in, meta)
+	cfg.Cluster = cluster.Name
+
+	return cfg, err
+}
+
+// clusterCreationConfig - configuration of generator deploying with defaults of config of the service.
+func (s *Service) clusterCreationConfig(in *desc.CreateGeneratorsParams, meta generatorMeta) (k8s.CreationConfig, error) {
+	template, err := s.template(in.Template)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	resources, err := s.resourceMapper.PBToModelWithTemplate(in.Resources, template.Resources)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	envs := mergeEnvs(template.Envs, EnvVarMapper{}.PbToModelMany(in.AdditionalEnvs))
+
+	image := in.Image
+	if image == "" {
+		image = template.Image
+	}
+
+	imgSpec, err := s.resolveImage(in, image)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	artifactsSidecar, err := s.artifactsSidecar()
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	exposeExternalIP := template.ExposeExternalIP
+	if in.ExposeExternalIp != nil {
+		exposeExternalIP = *in.ExposeExternalIp
+	}
+
+	commands := in.Commands
+	if len(commands) == 0 {
+		commands = template.Commands
+	}
+
+	args := in.Args
+	if len(args) == 0 {
+		args = template.Args
+	}
+
+	workingDir := in.WorkingDir
+	if workingDir == "" {
+		workingDir = template.WorkingDir
+	}
+
+	ports := PortMapper{}.PBToModelMany(in.Ports)
+	if len(ports) == 0 {
+		ports = template.Ports
+	}
+
+	readinessProbe := ProbeMapper{}.PBToModel(in.ReadinessProbe)
+	if readinessProbe == nil {
+		readinessProbe = template.ReadinessProbe
+	}
+
+	ttl, err := s.ttl(in)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	leaseDuration, err := lease(in)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	ammoMounts, err := s.ammoMounts(in)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	scheduling, err := s.scheduling(in)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	var spreadGroup string
+	if scheduling.Spread != model.SpreadNone {
+		spreadGroup = meta.spreadGroup
+	}
+
+	labels := mergeMaps(template.Labels, in.Labels)
+
+	namespace, err := s.namespace(in.Namespace, labels)
+	if err != nil {
+		return k8s.CreationConfig{}, err
+	}
+
+	return k8s.CreationConfig{
+		Namespace:        namespace,
+		Image:            imgSpec.image,
+		ImagePullPolicy:  imgSpec.pullPolicy,
+		ImagePullSecrets: imgSpec.pullSecrets,
 		Resources:        resources,
 		Envs:             envs,
-		Commands:         in.Commands,
-		ExposeExternalIP: in.ExposeExternalIp,
-	})
+		EnvFrom:          EnvVarMapper{}.EnvFromPBToModelMany(in.EnvFrom),
+		Commands:         commands,
+		Args:             args,
+		WorkingDir:       workingDir,
+		Ports:            ports,
+		ReadinessProbe:   readinessProbe,
+		ExposeExternalIP: exposeExternalIP,
+		Labels:           labels,
+		Annotations:      mergeMaps(template.Annotations, in.Annotations),
+		TTL:              ttl,
+		Lease:            leaseDuration,
+		Artifacts:        artifactsSidecar,
+		Files:            FileMapper{}.PBToModelMany(in.Files),
+		Ammo:             ammoMounts,
+		AmmoVolume:       s.ammoVolume(),
+		Scheduling:       scheduling,
+		SpreadGroup:      spreadGroup,
+		RunID:            meta.run.id,
+		RunName:          meta.run.name,
+		IdempotencyKey:   meta.idempotencyKey,
+		RequestHash:      meta.requestHash,
+		Index:            meta.index,
+	}, nil
+}
+
+// ttl - requested lifetime of generator; it must be positive and not exceed configured maximum.
+func (s *Service) ttl(in *desc.CreateGeneratorsParams) (time.Duration, error) {
+	if in.Ttl == nil {
+		return 0, nil
+	}
+
+	if err := in.Ttl.CheckValid(); err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidTTL, err)
+	}
+
+	ttl := in.Ttl.AsDuration()
+	if ttl <= 0 {
+		return 0, fmt.Errorf("%w: %s is not positive", errInvalidTTL, ttl)
+	}
+
+	if s.maxTTL > 0 && ttl > s.maxTTL {
+		return 0, fmt.Errorf("%w: %s exceeds maximum %s", errInvalidTTL, ttl, s.maxTTL)
+	}
 
-	return generator, err
+	return ttl, nil
 }
@@ -3,7 +3,10 @@ package lg_operator
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/spirt-t/lg-operator/internal/config"
@@ -13,6 +16,9 @@ import (
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestService_CreateGenerator(t *testing.T) {
@@ -31,7 +37,7 @@ func TestService_CreateGenerator(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	s := NewService(k8sManager, mngr, l, nil)
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
 
 	t.Run("ok", func(t *testing.T) {
 		lg := model.LoadGenerator{
@@ -147,4 +153,311 @@ func TestService_CreateGenerator(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, res)
 	})
+
+	t.Run("best effort", func(t *testing.T) {
+		lg := model.LoadGenerator{Name: "created", Port: 8888, Status: "Running"}
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				if cfg.Image == "badimage" {
+					return nil, errors.New("some error")
+				}
+
+				return &lg, nil
+			}).Times(2)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage"},
+				{Image: "badimage"},
+			},
+			Mode: desc.CreateGeneratorsRequest_BEST_EFFORT,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.LoadGenerators, 1)
+		assert.Equal(t, lg.Name, res.LoadGenerators[0].Name)
+		assert.Len(t, res.Results, 2)
+		assert.Equal(t, lg.Name, res.Results[0].GetLoadGenerator().GetName())
+		assert.Nil(t, res.Results[0].GetError())
+		assert.Equal(t, int32(codes.Unknown), res.Results[1].GetError().GetCode())
+		assert.Equal(t, "some error", res.Results[1].GetError().GetMessage())
+	})
+
+	t.Run("atomic rollback", func(t *testing.T) {
+		lg := model.LoadGenerator{Name: "created", Port: 8888, Status: "Running"}
+		created := make(chan struct{})
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				if cfg.Image == "badimage" {
+					// fail after the other generator is created
+					<-created
+					return nil, errors.New("some error")
+				}
+
+				defer close(created)
+				return &lg, nil
+			}).Times(2)
+		k8sManager.EXPECT().Delete(gomock.Any(), lg.Name).Return(nil)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage"},
+				{Image: "badimage"},
+			},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("idempotency key", func(t *testing.T) {
+		lg := model.LoadGenerator{Name: "load-generator-key-0", Port: 8888, Status: "Running"}
+
+		k8sManager.EXPECT().ListByIdempotencyKey(gomock.Any(), "key").Return(nil, nil)
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, "key", cfg.IdempotencyKey)
+				assert.Equal(t, 0, cfg.Index)
+
+				return &lg, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters:     []*desc.CreateGeneratorsParams{{Image: "testimage"}},
+			IdempotencyKey: "key",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, lg.Name, res.LoadGenerators[0].Name)
+	})
+
+	t.Run("idempotent retry", func(t *testing.T) {
+		existing := []model.LoadGenerator{
+			{Name: "load-generator-key-0", Port: 8888, Status: "Running"},
+			{Name: "load-generator-key-1", Port: 8888, Status: "Pending"},
+		}
+
+		k8sManager.EXPECT().ListByIdempotencyKey(gomock.Any(), "key").Return(existing, nil)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage"},
+				{Image: "testimage"},
+			},
+			IdempotencyKey: "key",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.LoadGenerators, 2)
+		assert.Equal(t, existing[0].Name, res.LoadGenerators[0].Name)
+		assert.Equal(t, existing[1].Name, res.Results[1].GetLoadGenerator().GetName())
+	})
+
+	t.Run("idempotent retry of another request", func(t *testing.T) {
+		in := &desc.CreateGeneratorsRequest{
+			Parameters:     []*desc.CreateGeneratorsParams{{Image: "testimage"}},
+			IdempotencyKey: "key",
+		}
+		other := &desc.CreateGeneratorsRequest{
+			Parameters:     []*desc.CreateGeneratorsParams{{Image: "otherimage"}},
+			IdempotencyKey: "key",
+		}
+
+		hash, err := requestHash(other)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		k8sManager.EXPECT().ListByIdempotencyKey(gomock.Any(), "key").Return([]model.LoadGenerator{
+			{Name: "load-generator-key-0", RequestHash: hash},
+		}, nil)
+
+		res, err := s.CreateGenerators(ctx, in)
+		assert.Nil(t, res)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("idempotent retry of partial creation", func(t *testing.T) {
+		in := &desc.CreateGeneratorsRequest{
+			Parameters:     []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 2}},
+			IdempotencyKey: "key",
+		}
+
+		hash, err := requestHash(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		k8sManager.EXPECT().ListByIdempotencyKey(gomock.Any(), "key").Return([]model.LoadGenerator{
+			{Name: "load-generator-key-0", RequestHash: hash},
+		}, nil)
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, 1, cfg.Index)
+				assert.Equal(t, hash, cfg.RequestHash)
+
+				return &model.LoadGenerator{Name: "load-generator-key-1"}, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, in)
+		assert.NoError(t, err)
+		assert.Len(t, res.LoadGenerators, 2)
+		assert.Equal(t, "load-generator-key-0", res.LoadGenerators[0].Name)
+		assert.Equal(t, "load-generator-key-1", res.LoadGenerators[1].Name)
+	})
+
+	t.Run("replicas", func(t *testing.T) {
+		var (
+			mu                sync.Mutex
+			active, maxActive int
+			indexes           []int
+		)
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				mu.Lock()
+				active++
+				if active > maxActive {
+					maxActive = active
+				}
+				indexes = append(indexes, cfg.Index)
+				mu.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+
+				return &model.LoadGenerator{Name: fmt.Sprintf("generator-%d", cfg.Index)}, nil
+			}).Times(5)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage", Replicas: 4},
+				{Image: "otherimage"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.LoadGenerators, 5)
+		assert.Equal(t, "generator-4", res.LoadGenerators[4].Name)
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, indexes)
+		// creation concurrency is limited in test config
+		assert.LessOrEqual(t, maxActive, 2)
+	})
+
+	t.Run("too many replicas", func(t *testing.T) {
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 6}},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("too many generators in total", func(t *testing.T) {
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage", Replicas: 5},
+				{Image: "otherimage", Replicas: 3},
+			},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "request has at least 8 generators, which exceeds maximum 5")
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, 10*time.Minute, cfg.TTL)
+				return &model.LoadGenerator{Name: "testname", TTL: cfg.TTL}, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Ttl: durationpb.New(10 * time.Minute)}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 10*time.Minute, res.LoadGenerators[0].Ttl.AsDuration())
+	})
+
+	t.Run("files", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, []model.File{
+					{Name: "load.yaml", MountPath: "/etc/tank", Content: "phantom: {}"},
+					{Name: "ammo.txt", MountPath: "/var/ammo", Content: "/ping"},
+				}, cfg.Files)
+				return &model.LoadGenerator{Name: "testname"}, nil
+			})
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				Files: []*desc.File{
+					{Name: "load.yaml", MountPath: "/etc/tank", Content: "phantom: {}"},
+					{Name: "ammo.txt", MountPath: "/var/ammo", Content: "/ping"},
+				},
+			}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("args, working dir and ports", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, []string{"-c", "load.yaml"}, cfg.Args)
+				assert.Equal(t, "/var/loadtest", cfg.WorkingDir)
+				assert.Equal(t, []model.Port{{Name: "api", Port: 8083}, {Name: "metrics", Port: 9090, Protocol: "TCP"}}, cfg.Ports)
+				return &model.LoadGenerator{Name: "testname", Port: 8083, Ports: cfg.Ports}, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image:      "testimage",
+				Args:       []string{"-c", "load.yaml"},
+				WorkingDir: "/var/loadtest",
+				Ports:      []*desc.Port{{Name: "api", Port: 8083}, {Name: "metrics", Port: 9090, Protocol: "TCP"}},
+			}},
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, int32(8083), res.LoadGenerators[0].Port)
+		assert.Len(t, res.LoadGenerators[0].Ports, 2)
+	})
+
+	t.Run("readiness probe", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.Equal(t, &model.Probe{
+					HTTPGet:             &model.HTTPGetProbe{Path: "/api/v1/status"},
+					InitialDelaySeconds: 10,
+				}, cfg.ReadinessProbe)
+				return &model.LoadGenerator{Name: "testname", Ready: true}, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image: "testimage",
+				ReadinessProbe: &desc.Probe{
+					Handler:             &desc.Probe_HttpGet{HttpGet: &desc.HTTPGetProbe{Path: "/api/v1/status"}},
+					InitialDelaySeconds: 10,
+				},
+			}},
+		})
+		if assert.NoError(t, err) {
+			assert.True(t, res.LoadGenerators[0].Ready)
+		}
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		for _, ttl := range []time.Duration{-time.Minute, 13 * time.Hour} {
+			res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+				Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Ttl: durationpb.New(ttl)}},
+				Mode:       desc.CreateGeneratorsRequest_BEST_EFFORT,
+			})
+			assert.Nil(t, res)
+			// maximum ttl is set in test config
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), ttl)
+		}
+	})
 }
@@ -29,7 +29,7 @@ func TestService_DeleteGenerator(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	s := NewService(k8sManager, mngr, l, nil)
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
 
 	t.Run("ok", func(t *testing.T) {
 		k8sManager.EXPECT().Delete(ctx, "test-generator-name").Return(nil)
@@ -0,0 +1,41 @@
+package lg_operator
+
+import (
+	"errors"
+
+	"github.com/spirt-t/lg-operator/internal/ammo"
+	"github.com/spirt-t/lg-operator/internal/artifact"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError - map known errors of k8s manager and service to grpc statuses; other errors are returned as is.
+func statusError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, k8s.ErrNotFound), errors.Is(err, k8s.ErrRunNotFound), errors.Is(err, operation.ErrNotFound),
+		errors.Is(err, artifact.ErrNotFound), errors.Is(err, ammo.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, operation.ErrFinished), errors.Is(err, errArtifactsDisabled),
+		errors.Is(err, errAmmoDisabled), errors.Is(err, errIdempotencyMismatch):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, k8s.ErrInvalidArgument), errors.Is(err, errUnknownTemplate),
+		errors.Is(err, errInvalidTTL), errors.Is(err, errInvalidLease), errors.Is(err, artifact.ErrInvalidName),
+		errors.Is(err, errInvalidAmmo), errors.Is(err, ammo.ErrTooLarge), errors.Is(err, ammo.ErrChecksumMismatch),
+		errors.Is(err, errImageNotAllowed), errors.Is(err, errUnknownCluster), errors.Is(err, errOwnerMissing):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, k8s.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error()+"; creation with the same idempotency key is in progress")
+	case errors.Is(err, k8s.ErrPageTokenExpired):
+		return status.Error(codes.OutOfRange, err.Error()+"; restart listing from the first page")
+	case errors.Is(err, k8s.ErrResourceVersionExpired):
+		return status.Error(codes.OutOfRange, err.Error()+"; restart watching without resource version")
+	}
+
+	return err
+}
@@ -0,0 +1,26 @@
+package lg_operator
+
+import (
+	"context"
+
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGenerator - get generator by name with diagnostics of its pod.
+func (s *Service) GetGenerator(ctx context.Context, in *desc.GetGeneratorRequest) (*desc.GetGeneratorResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "generator name is required")
+	}
+
+	details, err := s.k8s.Get(ctx, in.Name)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.GetGeneratorResponse{
+		LoadGenerator: GeneratorMapper{}.ModelToPB(details.Generator),
+		Diagnostics:   DiagnosticsMapper{}.ModelToPB(details.Diagnostics),
+	}, nil
+}
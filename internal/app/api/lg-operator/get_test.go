@@ -0,0 +1,104 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func TestService_GetGenerator(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("ok", func(t *testing.T) {
+		finishedAt := time.Now().Add(-time.Minute)
+		k8sManager.EXPECT().Get(ctx, "generator-1").Return(&model.GeneratorDetails{
+			Generator: model.LoadGenerator{
+				Name:      "generator-1",
+				ClusterIP: "127.0.0.1",
+				Port:      8888,
+				Status:    coreV1.PodFailed,
+			},
+			Diagnostics: model.GeneratorDiagnostics{
+				NodeName:          "node-1",
+				PodIP:             "10.0.0.1",
+				ContainerState:    model.ContainerStateTerminated,
+				FinishedAt:        finishedAt,
+				ExitCode:          137,
+				TerminationReason: "OOMKilled",
+				Events: []model.Event{
+					{
+						ObjectKind: "Pod",
+						Type:       "Normal",
+						Reason:     "Pulled",
+					},
+				},
+			},
+		}, nil)
+
+		res, err := s.GetGenerator(ctx, &desc.GetGeneratorRequest{Name: "generator-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "generator-1", res.LoadGenerator.Name)
+		assert.Equal(t, "127.0.0.1", res.LoadGenerator.ClusterIp)
+		assert.Equal(t, "Failed", res.LoadGenerator.Status)
+		assert.Equal(t, "node-1", res.Diagnostics.NodeName)
+		assert.Equal(t, "10.0.0.1", res.Diagnostics.PodIp)
+		assert.Equal(t, "terminated", res.Diagnostics.ContainerState)
+		assert.Equal(t, int32(137), res.Diagnostics.ExitCode)
+		assert.Equal(t, "OOMKilled", res.Diagnostics.TerminationReason)
+		assert.True(t, finishedAt.Equal(res.Diagnostics.FinishedAt.AsTime()))
+		assert.Nil(t, res.Diagnostics.StartedAt)
+		assert.Equal(t, 1, len(res.Diagnostics.Events))
+		assert.Equal(t, "Pulled", res.Diagnostics.Events[0].Reason)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		res, err := s.GetGenerator(ctx, &desc.GetGeneratorRequest{})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		k8sManager.EXPECT().Get(ctx, "generator-2").Return(nil, fmt.Errorf("%w: generator-2", k8s.ErrNotFound))
+
+		res, err := s.GetGenerator(ctx, &desc.GetGeneratorRequest{Name: "generator-2"})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		er := errors.New("some error")
+		k8sManager.EXPECT().Get(ctx, "generator-3").Return(nil, er)
+
+		res, err := s.GetGenerator(ctx, &desc.GetGeneratorRequest{Name: "generator-3"})
+		assert.Nil(t, res)
+		assert.True(t, errors.Is(err, er))
+	})
+}
@@ -0,0 +1,184 @@
+package lg_operator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+const (
+	imagesKey = "images"
+	// dockerHub - registry of images without explicit registry.
+	dockerHub = "docker.io"
+)
+
+var (
+	errImageNotAllowed = errors.New("image is not allowed")
+
+	pullPolicies = map[desc.ImagePullPolicy]string{
+		desc.ImagePullPolicy_ALWAYS:         "Always",
+		desc.ImagePullPolicy_IF_NOT_PRESENT: "IfNotPresent",
+		desc.ImagePullPolicy_NEVER:          "Never",
+	}
+)
+
+// imagesConfig - images section of config.
+/*
+  - PullPolicy - default pull policy of generators: Always, IfNotPresent or Never;
+  - PullSecrets - secrets with credentials of private registries used by all generators;
+  - Rewrites - rules of image rewriting applied in order, the first matching rule wins;
+  - AllowedRegistries - registries generators may pull images from; any registry if empty.
+*/
+type imagesConfig struct {
+	PullPolicy        string   `mapstructure:"pull_policy"`
+	PullSecrets       []string `mapstructure:"pull_secrets"`
+	Rewrites          []imageRewrite
+	AllowedRegistries []string `mapstructure:"allowed_registries"`
+}
+
+// imageRewrite - image starting with From is pulled from To followed by the rest of the image,
+// e.g. docker.io/* to mirror.example.com/dockerhub/*. Images are matched with registry made explicit.
+type imageRewrite struct {
+	From string
+	To   string
+}
+
+// imageSpec - image of generator and how it is pulled.
+type imageSpec struct {
+	image       string
+	pullPolicy  string
+	pullSecrets []string
+}
+
+// resolveImage - image of request rewritten by rules of config and checked against allowed registries.
+// Pull policy of request overrides the one of config, pull secrets of request are added to the ones of config.
+func (s *Service) resolveImage(in *desc.CreateGeneratorsParams, image string) (imageSpec, error) {
+	cfg, err := s.imagesConfig()
+	if err != nil {
+		return imageSpec{}, err
+	}
+
+	image = rewriteImage(image, cfg.Rewrites)
+	if err := checkRegistry(image, cfg.AllowedRegistries); err != nil {
+		return imageSpec{}, err
+	}
+
+	pullPolicy := cfg.PullPolicy
+	if policy, ok := pullPolicies[in.ImagePullPolicy]; ok {
+		pullPolicy = policy
+	}
+
+	return imageSpec{
+		image:       image,
+		pullPolicy:  pullPolicy,
+		pullSecrets: mergeNames(cfg.PullSecrets, in.ImagePullSecrets),
+	}, nil
+}
+
+// artifactsSidecar - artifacts sidecar of generators with its image rewritten and checked as image of generator.
+func (s *Service) artifactsSidecar() (k8s.ArtifactsConfig, error) {
+	sidecar := s.artifactsPod
+	if len(sidecar.Paths) == 0 {
+		return sidecar, nil
+	}
+
+	cfg, err := s.imagesConfig()
+	if err != nil {
+		return k8s.ArtifactsConfig{}, err
+	}
+
+	sidecar.Image = rewriteImage(sidecar.Image, cfg.Rewrites)
+	if err = checkRegistry(sidecar.Image, cfg.AllowedRegistries); err != nil {
+		return k8s.ArtifactsConfig{}, fmt.Errorf("artifacts sidecar: %w", err)
+	}
+
+	return sidecar, nil
+}
+
+func (s *Service) imagesConfig() (imagesConfig, error) {
+	var cfg imagesConfig
+	if err := s.config.UnmarshalKey(imagesKey, &cfg); err != nil {
+		return imagesConfig{}, fmt.Errorf("fail to define images config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// normalizeImage - image with explicit registry, e.g. docker.io/library/ubuntu:22.04 for ubuntu:22.04.
+func normalizeImage(image string) string {
+	first, _, found := strings.Cut(image, "/")
+	switch {
+	case !found:
+		return dockerHub + "/library/" + image
+	case strings.ContainsAny(first, ".:") || first == "localhost":
+		return image
+	default:
+		return dockerHub + "/" + image
+	}
+}
+
+// registry - registry of image, e.g. docker.io for ubuntu:22.04.
+func registry(image string) string {
+	registry, _, _ := strings.Cut(normalizeImage(image), "/")
+	return registry
+}
+
+// rewriteImage - image rewritten by the first matching rule; image is kept as is if no rule matches.
+func rewriteImage(image string, rewrites []imageRewrite) string {
+	if image == "" {
+		return image
+	}
+
+	normalized := normalizeImage(image)
+	for _, rewrite := range rewrites {
+		from := strings.TrimSuffix(rewrite.From, "*")
+		if from != "" && strings.HasPrefix(normalized, from) {
+			return strings.TrimSuffix(rewrite.To, "*") + strings.TrimPrefix(normalized, from)
+		}
+	}
+
+	return image
+}
+
+// checkRegistry - image must be pulled from one of allowed registries, if they are set.
+func checkRegistry(image string, allowed []string) error {
+	if image == "" || len(allowed) == 0 {
+		return nil
+	}
+
+	imageRegistry := registry(image)
+	for _, allowedRegistry := range allowed {
+		if strings.EqualFold(imageRegistry, allowedRegistry) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: registry %s of image %s is not one of allowed registries %s",
+		errImageNotAllowed, imageRegistry, image, strings.Join(allowed, ", "))
+}
+
+// mergeNames - names of config followed by names of request without duplicates.
+func mergeNames(config, request []string) []string {
+	if len(request) == 0 {
+		return config
+	}
+
+	merged := make([]string, 0, len(config)+len(request))
+	seen := make(map[string]struct{}, len(config)+len(request))
+
+	for _, names := range [][]string{config, request} {
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+
+			seen[name] = struct{}{}
+			merged = append(merged, name)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,138 @@
+package lg_operator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const imagesConfigContent = `
+images:
+  pull_policy: IfNotPresent
+  pull_secrets: ['registry-credentials']
+  rewrites:
+    - from: 'docker.io/*'
+      to: 'mirror.example.com/dockerhub/*'
+  allowed_registries: ['mirror.example.com', 'registry.example.com:5000']
+`
+
+func Test_rewriteImage(t *testing.T) {
+	rewrites := []imageRewrite{
+		{From: "docker.io/library/*", To: "mirror.example.com/library/*"},
+		{From: "docker.io/", To: "mirror.example.com/dockerhub/"},
+	}
+
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "ubuntu:22.04", want: "mirror.example.com/library/ubuntu:22.04"},
+		{image: "direvius/yandex-tank:latest", want: "mirror.example.com/dockerhub/direvius/yandex-tank:latest"},
+		{image: "docker.io/direvius/yandex-tank", want: "mirror.example.com/dockerhub/direvius/yandex-tank"},
+		{image: "registry.example.com:5000/tank", want: "registry.example.com:5000/tank"},
+		{image: "localhost/tank", want: "localhost/tank"},
+		{image: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			assert.Equal(t, tt.want, rewriteImage(tt.image, rewrites))
+		})
+	}
+}
+
+func Test_checkRegistry(t *testing.T) {
+	allowed := []string{"docker.io", "registry.example.com:5000"}
+
+	assert.NoError(t, checkRegistry("ubuntu", allowed))
+	assert.NoError(t, checkRegistry("registry.example.com:5000/tank:1", allowed))
+	assert.NoError(t, checkRegistry("quay.io/tank", nil))
+	assert.ErrorIs(t, checkRegistry("quay.io/tank", allowed), errImageNotAllowed)
+	assert.ErrorIs(t, checkRegistry("registry.example.com/tank", allowed), errImageNotAllowed)
+}
+
+func TestService_resolveImage(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(imagesConfigContent), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Service{config: mngr}
+
+	t.Run("defaults", func(t *testing.T) {
+		spec, err := s.resolveImage(&desc.CreateGeneratorsParams{}, "direvius/yandex-tank")
+		assert.NoError(t, err)
+		assert.Equal(t, imageSpec{
+			image:       "mirror.example.com/dockerhub/direvius/yandex-tank",
+			pullPolicy:  "IfNotPresent",
+			pullSecrets: []string{"registry-credentials"},
+		}, spec)
+	})
+
+	t.Run("merged with request", func(t *testing.T) {
+		spec, err := s.resolveImage(&desc.CreateGeneratorsParams{
+			ImagePullPolicy:  desc.ImagePullPolicy_ALWAYS,
+			ImagePullSecrets: []string{"team-credentials", "registry-credentials"},
+		}, "registry.example.com:5000/tank:1")
+		assert.NoError(t, err)
+		assert.Equal(t, imageSpec{
+			image:       "registry.example.com:5000/tank:1",
+			pullPolicy:  "Always",
+			pullSecrets: []string{"registry-credentials", "team-credentials"},
+		}, spec)
+	})
+
+	t.Run("registry not allowed", func(t *testing.T) {
+		_, err := s.resolveImage(&desc.CreateGeneratorsParams{}, "quay.io/tank")
+		assert.ErrorIs(t, err, errImageNotAllowed)
+		assert.Equal(t, codes.InvalidArgument, status.Code(statusError(err)))
+	})
+}
+
+func TestService_artifactsSidecar(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(imagesConfigContent), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("rewritten", func(t *testing.T) {
+		s := &Service{config: mngr, artifactsPod: k8s.ArtifactsConfig{Paths: []string{"/var/loadtest"}, Image: "busybox:1.36"}}
+
+		sidecar, err := s.artifactsSidecar()
+		assert.NoError(t, err)
+		assert.Equal(t, "mirror.example.com/dockerhub/library/busybox:1.36", sidecar.Image)
+	})
+
+	t.Run("registry not allowed", func(t *testing.T) {
+		s := &Service{config: mngr, artifactsPod: k8s.ArtifactsConfig{Paths: []string{"/var/loadtest"}, Image: "quay.io/busybox"}}
+
+		_, err := s.artifactsSidecar()
+		assert.ErrorIs(t, err, errImageNotAllowed)
+		assert.Equal(t, codes.InvalidArgument, status.Code(statusError(err)))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := &Service{config: mngr}
+
+		sidecar, err := s.artifactsSidecar()
+		assert.NoError(t, err)
+		assert.Empty(t, sidecar)
+	})
+}
@@ -0,0 +1,229 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errInvalidLease = errors.New("invalid lease duration")
+)
+
+// lease - requested lease duration of generator; generator has no lease if it is zero.
+func lease(in *desc.CreateGeneratorsParams) (time.Duration, error) {
+	if in.LeaseDuration == nil {
+		return 0, nil
+	}
+
+	if err := in.LeaseDuration.CheckValid(); err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidLease, err)
+	}
+
+	duration := in.LeaseDuration.AsDuration()
+	if duration <= 0 {
+		return 0, fmt.Errorf("%w: %s is not positive", errInvalidLease, duration)
+	}
+
+	return duration, nil
+}
+
+// RenewLease - extend leases of generators from now.
+// Leases of all generators are renewed even if some of them fail; the first error is returned.
+func (s *Service) RenewLease(ctx context.Context, in *desc.RenewLeaseRequest) (*desc.RenewLeaseResponse, error) {
+	if len(in.Names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "generator names are required")
+	}
+
+	var duration time.Duration
+	if in.Duration != nil {
+		if err := in.Duration.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: %v", errInvalidLease, err)
+		}
+
+		duration = in.Duration.AsDuration()
+		if duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: %s is not positive", errInvalidLease, duration)
+		}
+	}
+
+	generators, err := s.renewLeases(ctx, in.Names, duration)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.RenewLeaseResponse{LoadGenerators: GeneratorMapper{}.ModelToPBMany(generators)}, nil
+}
+
+func (s *Service) renewLeases(ctx context.Context, names []string, duration time.Duration) ([]model.LoadGenerator, error) {
+	var (
+		generators = make([]model.LoadGenerator, 0, len(names))
+		firstErr   error
+	)
+
+	for _, name := range names {
+		generator, err := s.k8s.RenewLease(ctx, name, duration)
+		if err != nil {
+			s.logger.Warn("fail to renew lease", zap.Error(err), zap.String("generator_name", name))
+
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		generators = append(generators, *generator)
+	}
+
+	return generators, firstErr
+}
+
+// HoldLease - hold leases of generators named in messages of the stream while it is open.
+// Leases of held generators are renewed by sessionLease at half of it, so they lapse soon
+// if the operator stops; the generators are deleted as soon as the stream is closed or broken.
+// Failed renewals do not end the session: they are reported by name in response to messages
+// and retried by the next renewal, while generators which are not found are not held anymore.
+func (s *Service) HoldLease(stream desc.LoadGeneratorOperatorService_HoldLeaseServer) error {
+	ctx := stream.Context()
+
+	var held []string
+	defer func() {
+		s.release(held)
+	}()
+
+	requests := make(chan *desc.HoldLeaseRequest)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case requests <- in:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(s.sessionLease / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// client has gone away
+			return nil
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		case in := <-requests:
+			var (
+				generators []model.LoadGenerator
+				failures   map[string]error
+			)
+
+			held, generators, failures = s.renewHeld(ctx, held, in.Names)
+
+			if err := stream.Send(&desc.HoldLeaseResponse{
+				LoadGenerators: GeneratorMapper{}.ModelToPBMany(generators),
+				Errors:         LeaseErrorMapper{}.ModelToPBMany(failures),
+			}); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			held, _, _ = s.renewHeld(ctx, held, nil)
+		}
+	}
+}
+
+// renewHeld - renew leases of held generators and of names to hold. Names are held once their leases are renewed.
+// Generators which are not found are released from the session, while the others stay held on failure,
+// so their leases are renewed by the next attempt. Held names and failures by name are returned.
+func (s *Service) renewHeld(ctx context.Context, held, names []string) ([]string, []model.LoadGenerator, map[string]error) {
+	var (
+		kept       = make([]string, 0, len(held)+len(names))
+		generators = make([]model.LoadGenerator, 0, len(held)+len(names))
+		failures   = make(map[string]error)
+		isHeld     = make(map[string]bool, len(held))
+	)
+
+	for _, name := range held {
+		isHeld[name] = true
+	}
+
+	for _, name := range appendNew(append([]string(nil), held...), names) {
+		generator, err := s.k8s.RenewLease(ctx, name, s.sessionLease)
+
+		switch {
+		case err == nil:
+			kept = append(kept, name)
+			generators = append(generators, *generator)
+		case errors.Is(err, k8s.ErrNotFound):
+			if isHeld[name] {
+				s.logger.Info("held generator is not found", zap.String("generator_name", name))
+			}
+
+			failures[name] = err
+		default:
+			s.logger.Warn("fail to renew lease of held generator", zap.Error(err), zap.String("generator_name", name))
+
+			if isHeld[name] {
+				kept = append(kept, name)
+			}
+
+			failures[name] = err
+		}
+	}
+
+	return kept, generators, failures
+}
+
+// release - delete generators held by closed session; ctx of the session is already done, so it is not used.
+func (s *Service) release(names []string) {
+	for _, name := range names {
+		if err := s.k8s.Delete(context.Background(), name); err != nil && !errors.Is(err, k8s.ErrNotFound) {
+			s.logger.Warn("fail to delete generator of closed lease session", zap.Error(err), zap.String("generator_name", name))
+		}
+	}
+
+	if len(names) > 0 {
+		s.logger.Info("deleted generators of closed lease session", zap.Strings("generators", names))
+	}
+}
+
+// appendNew - append names missing in list.
+func appendNew(list []string, names []string) []string {
+	for _, name := range names {
+		found := false
+		for _, item := range list {
+			if item == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			list = append(list, name)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,204 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type holdLeaseStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  []*desc.HoldLeaseRequest
+	responses []*desc.HoldLeaseResponse
+}
+
+func (hs *holdLeaseStream) Context() context.Context {
+	return hs.ctx
+}
+
+func (hs *holdLeaseStream) Recv() (*desc.HoldLeaseRequest, error) {
+	if len(hs.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	in := hs.requests[0]
+	hs.requests = hs.requests[1:]
+
+	return in, nil
+}
+
+func (hs *holdLeaseStream) Send(out *desc.HoldLeaseResponse) error {
+	hs.responses = append(hs.responses, out)
+	return nil
+}
+
+func TestService_RenewLease(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("ok", func(t *testing.T) {
+		expiresAt := time.Now().Add(5 * time.Minute)
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", 5*time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-1", LeaseExpiresAt: expiresAt}, nil)
+		k8sManager.EXPECT().RenewLease(ctx, "generator-2", 5*time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-2", LeaseExpiresAt: expiresAt}, nil)
+
+		res, err := s.RenewLease(ctx, &desc.RenewLeaseRequest{
+			Names:    []string{"generator-1", "generator-2"},
+			Duration: durationpb.New(5 * time.Minute),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.LoadGenerators, 2)
+		assert.Equal(t, expiresAt.Unix(), res.LoadGenerators[1].LeaseExpiresAt.AsTime().Unix())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		k8sManager.EXPECT().RenewLease(ctx, "unknown", time.Duration(0)).Return(nil, k8s.ErrNotFound)
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", time.Duration(0)).
+			Return(&model.LoadGenerator{Name: "generator-1"}, nil)
+
+		res, err := s.RenewLease(ctx, &desc.RenewLeaseRequest{Names: []string{"unknown", "generator-1"}})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, in := range []*desc.RenewLeaseRequest{
+			{},
+			{Names: []string{"generator-1"}, Duration: durationpb.New(-time.Minute)},
+		} {
+			res, err := s.RenewLease(ctx, in)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("invalid lease on creation", func(t *testing.T) {
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", LeaseDuration: durationpb.New(0)}},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestService_HoldLease(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("release on close", func(t *testing.T) {
+		stream := &holdLeaseStream{
+			ctx: ctx,
+			requests: []*desc.HoldLeaseRequest{
+				{Names: []string{"generator-1"}},
+				{Names: []string{"generator-1", "generator-2"}},
+			},
+		}
+
+		// session lease is set in test config
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-1"}, nil).Times(2)
+		k8sManager.EXPECT().RenewLease(ctx, "generator-2", time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-2"}, nil)
+		k8sManager.EXPECT().Delete(gomock.Any(), "generator-1").Return(nil)
+		k8sManager.EXPECT().Delete(gomock.Any(), "generator-2").Return(nil)
+
+		err := s.HoldLease(stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.responses, 2)
+		assert.Len(t, stream.responses[1].LoadGenerators, 2)
+	})
+
+	t.Run("unknown generator", func(t *testing.T) {
+		stream := &holdLeaseStream{
+			ctx: ctx,
+			requests: []*desc.HoldLeaseRequest{
+				{Names: []string{"generator-1"}},
+				{Names: []string{"unknown"}},
+			},
+		}
+
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-1"}, nil).Times(2)
+		k8sManager.EXPECT().RenewLease(ctx, "unknown", time.Minute).Return(nil, k8s.ErrNotFound)
+		// the session goes on, and only the held generator is deleted on close
+		k8sManager.EXPECT().Delete(gomock.Any(), "generator-1").Return(nil)
+
+		err := s.HoldLease(stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.responses, 2)
+		assert.Len(t, stream.responses[1].LoadGenerators, 1)
+		assert.Len(t, stream.responses[1].Errors, 1)
+		assert.Equal(t, "unknown", stream.responses[1].Errors[0].GeneratorName)
+		assert.Equal(t, int32(codes.NotFound), stream.responses[1].Errors[0].Code)
+	})
+
+	t.Run("transient error", func(t *testing.T) {
+		stream := &holdLeaseStream{
+			ctx: ctx,
+			requests: []*desc.HoldLeaseRequest{
+				{Names: []string{"generator-1", "generator-2"}},
+				{},
+			},
+		}
+
+		er := errors.New("some error")
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", time.Minute).
+			Return(&model.LoadGenerator{Name: "generator-1"}, nil)
+		k8sManager.EXPECT().RenewLease(ctx, "generator-1", time.Minute).Return(nil, er)
+		// a generator is not held until its lease is renewed
+		k8sManager.EXPECT().RenewLease(ctx, "generator-2", time.Minute).Return(nil, er)
+		// held generator stays held after failed renewal
+		k8sManager.EXPECT().Delete(gomock.Any(), "generator-1").Return(nil)
+
+		err := s.HoldLease(stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.responses, 2)
+		assert.Len(t, stream.responses[0].Errors, 1)
+		assert.Equal(t, "generator-2", stream.responses[0].Errors[0].GeneratorName)
+		assert.Len(t, stream.responses[1].Errors, 1)
+		assert.Equal(t, "generator-1", stream.responses[1].Errors[0].GeneratorName)
+	})
+}
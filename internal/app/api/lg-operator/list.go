@@ -2,18 +2,92 @@ package lg_operator
 
 import (
 	"context"
+	"sort"
 
+	"github.com/spirt-t/lg-operator/internal/model"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxPageSize = 500
 )
 
 // GeneratorsList - list of launched generators.
-func (s *Service) GeneratorsList(ctx context.Context, _ *desc.GeneratorsListRequest) (*desc.GeneratorsListResponse, error) {
-	generators, err := s.k8s.List(ctx)
+func (s *Service) GeneratorsList(ctx context.Context, in *desc.GeneratorsListRequest) (*desc.GeneratorsListResponse, error) {
+	filter, err := GeneratorFilterMapper{}.PBToModel(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if in.PageSize != 0 || in.PageToken != "" {
+		return s.generatorsPage(ctx, in, filter)
+	}
+
+	generators, err := s.k8s.List(ctx, filter)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 
+	sortGenerators(generators, in.SortBy, in.Descending)
+
 	list := GeneratorMapper{}.ModelToPBMany(generators)
 
 	return &desc.GeneratorsListResponse{LoadGenerators: list}, nil
 }
+
+// generatorsPage - page of generators ordered by name as k8s lists them.
+func (s *Service) generatorsPage(
+	ctx context.Context,
+	in *desc.GeneratorsListRequest,
+	filter model.GeneratorFilter) (*desc.GeneratorsListResponse, error) {
+	if in.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	if in.SortBy != desc.GeneratorsListRequest_NAME || in.Descending {
+		return nil, status.Error(codes.InvalidArgument, "paginated generators can be sorted only by name in ascending order")
+	}
+
+	size := int64(in.PageSize)
+	if size == 0 || size > maxPageSize {
+		size = maxPageSize
+	}
+
+	page, err := s.k8s.ListPage(ctx, filter, model.PageRequest{Size: size, Token: in.PageToken})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.GeneratorsListResponse{
+		LoadGenerators: GeneratorMapper{}.ModelToPBMany(page.Generators),
+		NextPageToken:  page.NextPageToken,
+	}, nil
+}
+
+// sortGenerators - stable sort of generators; generators with equal keys are ordered by name.
+func sortGenerators(generators []model.LoadGenerator, sortBy desc.GeneratorsListRequest_SortBy, descending bool) {
+	less := func(a, b model.LoadGenerator) bool {
+		switch sortBy {
+		case desc.GeneratorsListRequest_CREATED_AT:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case desc.GeneratorsListRequest_STATUS:
+			if a.Status != b.Status {
+				return a.Status < b.Status
+			}
+		}
+
+		return a.Name < b.Name
+	}
+
+	sort.SliceStable(generators, func(i, j int) bool {
+		if descending {
+			return less(generators[j], generators[i])
+		}
+
+		return less(generators[i], generators[j])
+	})
+}
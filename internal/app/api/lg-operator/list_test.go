@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
 	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
 	"github.com/spirt-t/lg-operator/internal/model"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	coreV1 "k8s.io/api/core/v1"
 )
 
 func TestService_GeneratorsList(t *testing.T) {
@@ -29,10 +35,10 @@ func TestService_GeneratorsList(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	s := NewService(k8sManager, mngr, l, nil)
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
 
 	t.Run("empty list", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return(nil, nil)
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, nil)
 
 		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{})
 		assert.NoError(t, err)
@@ -40,7 +46,7 @@ func TestService_GeneratorsList(t *testing.T) {
 	})
 
 	t.Run("ok", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return([]model.LoadGenerator{
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
 			{
 				Name:      "generator-1",
 				ClusterIP: "127.0.0.1",
@@ -72,11 +78,87 @@ func TestService_GeneratorsList(t *testing.T) {
 
 	t.Run("error", func(t *testing.T) {
 		er := errors.New("some error")
-		k8sManager.EXPECT().List(ctx).Return(nil, er)
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, er)
 
 		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{})
 		assert.Nil(t, res)
 		assert.NotNil(t, err)
 		assert.True(t, errors.Is(err, er))
 	})
+
+	t.Run("filter and sort", func(t *testing.T) {
+		createdAfter := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{
+			LabelSelector: "team=perf",
+			Statuses:      []coreV1.PodPhase{coreV1.PodRunning, coreV1.PodPending},
+			Image:         "tank",
+			CreatedAfter:  createdAfter,
+		}).Return([]model.LoadGenerator{
+			{Name: "generator-1", CreatedAt: createdAfter.Add(time.Minute)},
+			{Name: "generator-2", CreatedAt: createdAfter.Add(time.Hour)},
+			{Name: "generator-3", CreatedAt: createdAfter.Add(time.Minute)},
+		}, nil)
+
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{
+			LabelSelector: "team=perf",
+			Statuses:      []string{"Running", "Pending"},
+			Image:         "tank",
+			CreatedAfter:  timestamppb.New(createdAfter),
+			SortBy:        desc.GeneratorsListRequest_CREATED_AT,
+			Descending:    true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(res.LoadGenerators))
+		assert.Equal(t, "generator-2", res.LoadGenerators[0].Name)
+		assert.Equal(t, "generator-3", res.LoadGenerators[1].Name)
+		assert.Equal(t, "generator-1", res.LoadGenerators[2].Name)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{Statuses: []string{"running"}})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("page", func(t *testing.T) {
+		k8sManager.EXPECT().ListPage(ctx, model.GeneratorFilter{}, model.PageRequest{Size: 2, Token: "token"}).
+			Return(model.GeneratorsPage{
+				Generators:    []model.LoadGenerator{{Name: "generator-3"}, {Name: "generator-4"}},
+				NextPageToken: "next",
+			}, nil)
+
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{PageSize: 2, PageToken: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(res.LoadGenerators))
+		assert.Equal(t, "generator-3", res.LoadGenerators[0].Name)
+		assert.Equal(t, "next", res.NextPageToken)
+	})
+
+	t.Run("page size limit", func(t *testing.T) {
+		k8sManager.EXPECT().ListPage(ctx, model.GeneratorFilter{}, model.PageRequest{Size: maxPageSize}).
+			Return(model.GeneratorsPage{}, nil)
+
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{PageSize: maxPageSize + 1})
+		assert.NoError(t, err)
+		assert.Empty(t, res.NextPageToken)
+	})
+
+	t.Run("page with sorting", func(t *testing.T) {
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{
+			PageSize: 2,
+			SortBy:   desc.GeneratorsListRequest_CREATED_AT,
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("expired page token", func(t *testing.T) {
+		k8sManager.EXPECT().ListPage(ctx, model.GeneratorFilter{}, model.PageRequest{Size: 2, Token: "token"}).
+			Return(model.GeneratorsPage{}, k8s.ErrPageTokenExpired)
+
+		res, err := s.GeneratorsList(ctx, &desc.GeneratorsListRequest{PageSize: 2, PageToken: "token"})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
 }
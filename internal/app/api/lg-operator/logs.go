@@ -0,0 +1,52 @@
+package lg_operator
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamGeneratorLogs - stream logs of generator container line by line.
+func (s *Service) StreamGeneratorLogs(in *desc.StreamGeneratorLogsRequest, stream desc.LoadGeneratorOperatorService_StreamGeneratorLogsServer) error {
+	if in.Name == "" {
+		return status.Error(codes.InvalidArgument, "generator name is required")
+	}
+
+	if in.TailLines < 0 {
+		return status.Error(codes.InvalidArgument, "tail_lines must not be negative")
+	}
+
+	ctx := stream.Context()
+
+	logs, err := s.k8s.Logs(ctx, in.Name, LogOptionsMapper{}.PBToModel(in))
+	if err != nil {
+		return statusError(err)
+	}
+	defer logs.Close()
+
+	reader := bufio.NewReader(logs)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			if er := stream.Send(&desc.StreamGeneratorLogsResponse{Line: strings.TrimSuffix(line, "\n")}); er != nil {
+				return er
+			}
+		}
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil && ctx.Err() != nil:
+			// client has gone away
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
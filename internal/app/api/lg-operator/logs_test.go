@@ -0,0 +1,109 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type logsStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	lines []string
+}
+
+func (ls *logsStream) Context() context.Context {
+	return ls.ctx
+}
+
+func (ls *logsStream) Send(resp *desc.StreamGeneratorLogsResponse) error {
+	ls.lines = append(ls.lines, resp.Line)
+	return nil
+}
+
+func TestService_StreamGeneratorLogs(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("ok", func(t *testing.T) {
+		stream := &logsStream{ctx: ctx}
+		since := time.Now().Add(-time.Hour)
+
+		k8sManager.EXPECT().Logs(ctx, "generator-1", model.LogOptions{
+			Follow:     true,
+			TailLines:  10,
+			SinceTime:  since.UTC(),
+			Timestamps: true,
+		}).Return(io.NopCloser(strings.NewReader("line 1\nline 2\nline 3")), nil)
+
+		err = s.StreamGeneratorLogs(&desc.StreamGeneratorLogsRequest{
+			Name:       "generator-1",
+			Follow:     true,
+			TailLines:  10,
+			SinceTime:  timestamppb.New(since),
+			Timestamps: true,
+		}, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"line 1", "line 2", "line 3"}, stream.lines)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		stream := &logsStream{ctx: ctx}
+
+		err = s.StreamGeneratorLogs(&desc.StreamGeneratorLogsRequest{}, stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		err = s.StreamGeneratorLogs(&desc.StreamGeneratorLogsRequest{Name: "generator-1", TailLines: -1}, stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		stream := &logsStream{ctx: ctx}
+
+		k8sManager.EXPECT().Logs(ctx, "generator-2", model.LogOptions{}).
+			Return(nil, fmt.Errorf("%w: generator-2", k8s.ErrNotFound))
+
+		err = s.StreamGeneratorLogs(&desc.StreamGeneratorLogsRequest{Name: "generator-2"}, stream)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		stream := &logsStream{ctx: ctx}
+		er := errors.New("some error")
+
+		k8sManager.EXPECT().Logs(ctx, "generator-3", model.LogOptions{}).Return(nil, er)
+
+		err = s.StreamGeneratorLogs(&desc.StreamGeneratorLogsRequest{Name: "generator-3"}, stream)
+		assert.True(t, errors.Is(err, er))
+	})
+}
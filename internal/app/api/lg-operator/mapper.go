@@ -1,15 +1,35 @@
 package lg_operator
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/spirt-t/lg-operator/internal/config"
 	"github.com/spirt-t/lg-operator/internal/model"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	coreV1 "k8s.io/api/core/v1"
 )
 
 const (
 	defaultResourcesKey = "default_resources"
 )
 
+var (
+	podPhases = map[coreV1.PodPhase]struct{}{
+		coreV1.PodPending:   {},
+		coreV1.PodRunning:   {},
+		coreV1.PodSucceeded: {},
+		coreV1.PodFailed:    {},
+		coreV1.PodUnknown:   {},
+	}
+)
+
 // ResourceMapper ...
 type ResourceMapper struct {
 	cfg config.Manager
@@ -24,48 +44,65 @@ func NewResourceMapper(cfg config.Manager) *ResourceMapper {
 
 // PBToModel ...
 func (m *ResourceMapper) PBToModel(resources *desc.Resources) (model.Resources, error) {
+	return m.PBToModelWithTemplate(resources, model.Resources{})
+}
+
+// PBToModelWithTemplate - resources of request override resources of template, which override default resources.
+func (m *ResourceMapper) PBToModelWithTemplate(resources *desc.Resources, template model.Resources) (model.Resources, error) {
 	var r model.Resources
 
 	if err := m.cfg.UnmarshalKey(defaultResourcesKey, &r); err != nil {
 		return model.Resources{}, err
 	}
 
+	overrideResource(&r.CPU, template.CPU.Limit, template.CPU.Request)
+	overrideResource(&r.Memory, template.Memory.Limit, template.Memory.Request)
+
 	if resources != nil {
 		if resources.Cpu != nil {
-			if resources.Cpu.Request != "" {
-				r.CPU.Request = resources.Cpu.Request
-			}
-
-			if resources.Cpu.Limit != "" {
-				r.CPU.Limit = resources.Cpu.Limit
-			}
+			overrideResource(&r.CPU, resources.Cpu.Limit, resources.Cpu.Request)
 		}
 
 		if resources.Memory != nil {
-			if resources.Memory.Request != "" {
-				r.Memory.Request = resources.Memory.Request
-			}
-
-			if resources.Memory.Limit != "" {
-				r.Memory.Limit = resources.Memory.Limit
-			}
+			overrideResource(&r.Memory, resources.Memory.Limit, resources.Memory.Request)
 		}
 	}
 
 	return r, nil
 }
 
+func overrideResource(r *model.Resource, limit, request string) {
+	if request != "" {
+		r.Request = request
+	}
+
+	if limit != "" {
+		r.Limit = limit
+	}
+}
+
 // GeneratorMapper ...
 type GeneratorMapper struct{}
 
 // ModelToPB - map generator model to proto-message.
 func (gm GeneratorMapper) ModelToPB(generator model.LoadGenerator) *desc.LoadGenerator {
 	return &desc.LoadGenerator{
-		Name:       generator.Name,
-		ClusterIp:  generator.ClusterIP,
-		ExternalIp: generator.ExternalIP,
-		Port:       generator.Port,
-		Status:     string(generator.Status),
+		Name:           generator.Name,
+		Cluster:        generator.Cluster,
+		Namespace:      generator.Namespace,
+		ClusterIp:      generator.ClusterIP,
+		ExternalIp:     generator.ExternalIP,
+		Port:           generator.Port,
+		Ports:          PortMapper{}.ModelToPBMany(generator.Ports),
+		Status:         string(generator.Status),
+		Ready:          generator.Ready,
+		Labels:         generator.Labels,
+		Annotations:    generator.Annotations,
+		Image:          generator.Image,
+		CreatedAt:      timeToPB(generator.CreatedAt),
+		Ttl:            durationToPB(generator.TTL),
+		LeaseExpiresAt: timeToPB(generator.LeaseExpiresAt),
+		AmmoIds:        generator.AmmoIDs,
 	}
 }
 
@@ -89,11 +126,58 @@ func (em EnvVarMapper) PBToModel(envVar *desc.EnvVar) *model.EnvVar {
 	}
 
 	return &model.EnvVar{
-		Name:  envVar.Name,
-		Value: envVar.Val,
+		Name:            envVar.Name,
+		Value:           envVar.Val,
+		SecretKeyRef:    keyRefToModel(envVar.SecretKeyRef),
+		ConfigMapKeyRef: keyRefToModel(envVar.ConfigMapKeyRef),
 	}
 }
 
+// ModelToPB - map environment variable model to proto-message.
+func (em EnvVarMapper) ModelToPB(envVar model.EnvVar) *desc.EnvVar {
+	return &desc.EnvVar{
+		Name:            envVar.Name,
+		Val:             envVar.Value,
+		SecretKeyRef:    keyRefToPB(envVar.SecretKeyRef),
+		ConfigMapKeyRef: keyRefToPB(envVar.ConfigMapKeyRef),
+	}
+}
+
+// EnvFromPBToModelMany - map imports of secrets and config maps; nil if there are none.
+func (em EnvVarMapper) EnvFromPBToModelMany(envFrom []*desc.EnvFromSource) []model.EnvFrom {
+	if len(envFrom) == 0 {
+		return nil
+	}
+
+	list := make([]model.EnvFrom, 0, len(envFrom))
+	for _, from := range envFrom {
+		list = append(list, model.EnvFrom{
+			Prefix:        from.Prefix,
+			SecretName:    from.GetSecretName(),
+			ConfigMapName: from.GetConfigMapName(),
+			Optional:      from.Optional,
+		})
+	}
+
+	return list
+}
+
+func keyRefToModel(selector *desc.KeySelector) *model.KeyRef {
+	if selector == nil {
+		return nil
+	}
+
+	return &model.KeyRef{Name: selector.Name, Key: selector.Key, Optional: selector.Optional}
+}
+
+func keyRefToPB(ref *model.KeyRef) *desc.KeySelector {
+	if ref == nil {
+		return nil
+	}
+
+	return &desc.KeySelector{Name: ref.Name, Key: ref.Key, Optional: ref.Optional}
+}
+
 // PbToModelMany ...
 func (em EnvVarMapper) PbToModelMany(envVars []*desc.EnvVar) []model.EnvVar {
 	listEnvVars := make([]model.EnvVar, 0, len(envVars))
@@ -106,3 +190,562 @@ func (em EnvVarMapper) PbToModelMany(envVars []*desc.EnvVar) []model.EnvVar {
 
 	return listEnvVars
 }
+
+// FileMapper ...
+type FileMapper struct{}
+
+// PBToModelMany - map files of generator; nil if there are no files.
+func (fm FileMapper) PBToModelMany(files []*desc.File) []model.File {
+	if len(files) == 0 {
+		return nil
+	}
+
+	list := make([]model.File, 0, len(files))
+	for _, file := range files {
+		list = append(list, model.File{
+			Name:      file.Name,
+			MountPath: file.MountPath,
+			Content:   file.Content,
+		})
+	}
+
+	return list
+}
+
+// PortMapper ...
+type PortMapper struct{}
+
+// PBToModelMany - map ports of generator; nil if there are no ports.
+func (pm PortMapper) PBToModelMany(ports []*desc.Port) []model.Port {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	list := make([]model.Port, 0, len(ports))
+	for _, port := range ports {
+		list = append(list, model.Port{Name: port.Name, Port: port.Port, Protocol: port.Protocol})
+	}
+
+	return list
+}
+
+// ModelToPBMany - map ports of generator to proto-message.
+func (pm PortMapper) ModelToPBMany(ports []model.Port) []*desc.Port {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	list := make([]*desc.Port, 0, len(ports))
+	for _, port := range ports {
+		list = append(list, &desc.Port{Name: port.Name, Port: port.Port, Protocol: port.Protocol})
+	}
+
+	return list
+}
+
+// ProbeMapper ...
+type ProbeMapper struct{}
+
+// PBToModel - map readiness probe; nil if probe is not set.
+func (pm ProbeMapper) PBToModel(probe *desc.Probe) *model.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	res := &model.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+
+	switch handler := probe.Handler.(type) {
+	case *desc.Probe_HttpGet:
+		res.HTTPGet = &model.HTTPGetProbe{Path: handler.HttpGet.GetPath(), Port: handler.HttpGet.GetPort(), Scheme: handler.HttpGet.GetScheme()}
+	case *desc.Probe_TcpSocket:
+		res.TCPSocket = &model.TCPSocketProbe{Port: handler.TcpSocket.GetPort()}
+	case *desc.Probe_Exec:
+		res.Exec = &model.ExecProbe{Command: handler.Exec.GetCommand()}
+	}
+
+	return res
+}
+
+// ModelToPB - map readiness probe to proto-message; nil if probe is not set.
+func (pm ProbeMapper) ModelToPB(probe *model.Probe) *desc.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	res := &desc.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+
+	switch {
+	case probe.HTTPGet != nil:
+		res.Handler = &desc.Probe_HttpGet{HttpGet: &desc.HTTPGetProbe{
+			Path:   probe.HTTPGet.Path,
+			Port:   probe.HTTPGet.Port,
+			Scheme: probe.HTTPGet.Scheme,
+		}}
+	case probe.TCPSocket != nil:
+		res.Handler = &desc.Probe_TcpSocket{TcpSocket: &desc.TCPSocketProbe{Port: probe.TCPSocket.Port}}
+	case probe.Exec != nil:
+		res.Handler = &desc.Probe_Exec{Exec: &desc.ExecProbe{Command: probe.Exec.Command}}
+	}
+
+	return res
+}
+
+// SchedulingMapper ...
+type SchedulingMapper struct{}
+
+// PBToModel - map scheduling constraints of generator parameters.
+func (sm SchedulingMapper) PBToModel(in *desc.CreateGeneratorsParams) model.Scheduling {
+	scheduling := model.Scheduling{NodeSelector: in.NodeSelector}
+
+	for _, toleration := range in.Tolerations {
+		scheduling.Tolerations = append(scheduling.Tolerations, model.Toleration{
+			Key:               toleration.Key,
+			Operator:          toleration.Operator,
+			Value:             toleration.Value,
+			Effect:            toleration.Effect,
+			TolerationSeconds: toleration.TolerationSeconds,
+		})
+	}
+
+	if in.Affinity != nil {
+		affinity := &model.Affinity{}
+		for _, requirement := range in.Affinity.Required {
+			affinity.Required = append(affinity.Required, nodeSelectorRequirementToModel(requirement))
+		}
+
+		for _, preferred := range in.Affinity.Preferred {
+			affinity.Preferred = append(affinity.Preferred, model.PreferredNodeSelectorRequirement{
+				Weight:      preferred.Weight,
+				Requirement: nodeSelectorRequirementToModel(preferred.Requirement),
+			})
+		}
+
+		scheduling.Affinity = affinity
+	}
+
+	if in.Spread != desc.Spread_SPREAD_NONE {
+		scheduling.Spread = model.Spread(in.Spread.String())
+	}
+
+	return scheduling
+}
+
+func nodeSelectorRequirementToModel(requirement *desc.NodeSelectorRequirement) model.NodeSelectorRequirement {
+	if requirement == nil {
+		return model.NodeSelectorRequirement{}
+	}
+
+	return model.NodeSelectorRequirement{
+		Key:      requirement.Key,
+		Operator: requirement.Operator,
+		Values:   requirement.Values,
+	}
+}
+
+// AmmoMapper ...
+type AmmoMapper struct{}
+
+// ModelToPB - map ammo model to proto-message.
+func (am AmmoMapper) ModelToPB(ammo model.Ammo) *desc.Ammo {
+	return &desc.Ammo{
+		Id:        ammo.ID,
+		Name:      ammo.Name,
+		Size:      ammo.Size,
+		Sha256:    ammo.SHA256,
+		CreatedAt: timeToPB(ammo.CreatedAt),
+	}
+}
+
+// DiagnosticsMapper ...
+type DiagnosticsMapper struct{}
+
+// ModelToPB - map generator diagnostics model to proto-message.
+func (dm DiagnosticsMapper) ModelToPB(diagnostics model.GeneratorDiagnostics) *desc.GeneratorDiagnostics {
+	events := make([]*desc.Event, 0, len(diagnostics.Events))
+	for _, event := range diagnostics.Events {
+		events = append(events, &desc.Event{
+			ObjectKind:    event.ObjectKind,
+			Type:          event.Type,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: timeToPB(event.LastTimestamp),
+		})
+	}
+
+	return &desc.GeneratorDiagnostics{
+		NodeName:           diagnostics.NodeName,
+		PodIp:              diagnostics.PodIP,
+		ContainerState:     string(diagnostics.ContainerState),
+		WaitingReason:      diagnostics.WaitingReason,
+		RestartCount:       diagnostics.RestartCount,
+		StartedAt:          timeToPB(diagnostics.StartedAt),
+		FinishedAt:         timeToPB(diagnostics.FinishedAt),
+		ExitCode:           diagnostics.ExitCode,
+		TerminationReason:  diagnostics.TerminationReason,
+		TerminationMessage: diagnostics.TerminationMessage,
+		Events:             events,
+	}
+}
+
+// timeToPB - map time to proto-timestamp; zero time is mapped to nil.
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// durationToPB - map duration to proto-duration; zero duration is mapped to nil.
+func durationToPB(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+
+	return durationpb.New(d)
+}
+
+// GeneratorEventMapper ...
+type GeneratorEventMapper struct{}
+
+// ModelToPB - map generator change to proto-message.
+func (em GeneratorEventMapper) ModelToPB(event model.GeneratorEvent) *desc.WatchGeneratorsResponse {
+	eventType := desc.WatchGeneratorsResponse_EVENT_TYPE_UNSPECIFIED
+	if t, ok := desc.WatchGeneratorsResponse_EventType_value[string(event.Type)]; ok {
+		eventType = desc.WatchGeneratorsResponse_EventType(t)
+	}
+
+	return &desc.WatchGeneratorsResponse{
+		Type:            eventType,
+		LoadGenerator:   GeneratorMapper{}.ModelToPB(event.Generator),
+		ResourceVersion: event.ResourceVersion,
+	}
+}
+
+// LogOptionsMapper ...
+type LogOptionsMapper struct{}
+
+// PBToModel - map logs request to options of logs reading.
+func (lm LogOptionsMapper) PBToModel(in *desc.StreamGeneratorLogsRequest) model.LogOptions {
+	opts := model.LogOptions{
+		Follow:     in.Follow,
+		TailLines:  in.TailLines,
+		Timestamps: in.Timestamps,
+	}
+
+	if in.SinceTime != nil {
+		opts.SinceTime = in.SinceTime.AsTime()
+	}
+
+	return opts
+}
+
+// OperationMapper ...
+type OperationMapper struct{}
+
+// ModelToPB - map long-running operation to proto-message.
+func (om OperationMapper) ModelToPB(op model.Operation) *desc.Operation {
+	generators := make([]*desc.GeneratorProgress, 0, len(op.Generators))
+	for _, progress := range op.Generators {
+		pbProgress := &desc.GeneratorProgress{
+			Name:    progress.Name,
+			Stage:   desc.GeneratorProgress_Stage(desc.GeneratorProgress_Stage_value[string(progress.Stage)]),
+			Message: progress.Message,
+		}
+
+		if progress.Generator != nil {
+			pbProgress.LoadGenerator = GeneratorMapper{}.ModelToPB(*progress.Generator)
+		}
+
+		generators = append(generators, pbProgress)
+	}
+
+	return &desc.Operation{
+		Id:         op.ID,
+		Status:     desc.Operation_Status(desc.Operation_Status_value[string(op.Status)]),
+		Error:      op.Error,
+		CreatedAt:  timeToPB(op.CreatedAt),
+		UpdatedAt:  timeToPB(op.UpdatedAt),
+		Generators: generators,
+	}
+}
+
+// ModelToPBMany - map long-running operations to proto-message.
+func (om OperationMapper) ModelToPBMany(ops []model.Operation) []*desc.Operation {
+	list := make([]*desc.Operation, 0, len(ops))
+	for _, op := range ops {
+		list = append(list, om.ModelToPB(op))
+	}
+
+	return list
+}
+
+// CreationResultMapper ...
+type CreationResultMapper struct{}
+
+// ModelToPB - map result of generator creation to proto-message.
+func (cm CreationResultMapper) ModelToPB(result model.CreationResult) *desc.CreationResult {
+	if result.Err != nil {
+		st := status.Convert(statusError(result.Err))
+		if errors.Is(result.Err, context.Canceled) || errors.Is(result.Err, context.DeadlineExceeded) {
+			st = status.FromContextError(result.Err)
+		}
+
+		return &desc.CreationResult{
+			Result: &desc.CreationResult_Error{
+				Error: &desc.CreationError{
+					Code:    int32(st.Code()),
+					Message: st.Message(),
+				},
+			},
+		}
+	}
+
+	return &desc.CreationResult{
+		Result: &desc.CreationResult_LoadGenerator{
+			LoadGenerator: GeneratorMapper{}.ModelToPB(*result.Generator),
+		},
+	}
+}
+
+// ModelToPBMany - map results of generators creation to proto-message.
+func (cm CreationResultMapper) ModelToPBMany(results []model.CreationResult) []*desc.CreationResult {
+	list := make([]*desc.CreationResult, 0, len(results))
+	for _, result := range results {
+		list = append(list, cm.ModelToPB(result))
+	}
+
+	return list
+}
+
+// LeaseErrorMapper ...
+type LeaseErrorMapper struct{}
+
+// ModelToPB - map failed renewal of lease of generator to proto-message.
+func (lm LeaseErrorMapper) ModelToPB(name string, err error) *desc.LeaseError {
+	st := status.Convert(statusError(err))
+
+	return &desc.LeaseError{
+		GeneratorName: name,
+		Code:          int32(st.Code()),
+		Message:       st.Message(),
+	}
+}
+
+// ModelToPBMany - map failed renewals by name ordered by name.
+func (lm LeaseErrorMapper) ModelToPBMany(failures map[string]error) []*desc.LeaseError {
+	names := make([]string, 0, len(failures))
+	for name := range failures {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	list := make([]*desc.LeaseError, 0, len(names))
+	for _, name := range names {
+		list = append(list, lm.ModelToPB(name, failures[name]))
+	}
+
+	return list
+}
+
+// GeneratorFilterMapper ...
+type GeneratorFilterMapper struct{}
+
+// PBToModel - map list request to filter of generators.
+func (fm GeneratorFilterMapper) PBToModel(in *desc.GeneratorsListRequest) (model.GeneratorFilter, error) {
+	filter := model.GeneratorFilter{
+		Cluster:       in.Cluster,
+		Namespace:     in.Namespace,
+		LabelSelector: in.LabelSelector,
+		Image:         in.Image,
+	}
+
+	for _, st := range in.Statuses {
+		phase := coreV1.PodPhase(st)
+		if _, ok := podPhases[phase]; !ok {
+			return model.GeneratorFilter{}, fmt.Errorf("unknown status %q", st)
+		}
+
+		filter.Statuses = append(filter.Statuses, phase)
+	}
+
+	if in.CreatedAfter != nil {
+		filter.CreatedAfter = in.CreatedAfter.AsTime()
+	}
+
+	if in.CreatedBefore != nil {
+		filter.CreatedBefore = in.CreatedBefore.AsTime()
+	}
+
+	return filter, nil
+}
+
+// RunMapper ...
+type RunMapper struct{}
+
+// ModelToPB - map run to proto-message.
+func (rm RunMapper) ModelToPB(run model.Run) *desc.Run {
+	return &desc.Run{
+		Id:             run.ID,
+		Name:           run.Name,
+		Status:         desc.Run_Status(desc.Run_Status_value[string(run.Status())]),
+		CreatedAt:      timeToPB(run.CreatedAt),
+		LoadGenerators: GeneratorMapper{}.ModelToPBMany(run.Generators),
+	}
+}
+
+// ModelToPBMany - map runs to proto-message.
+func (rm RunMapper) ModelToPBMany(runs []model.Run) []*desc.Run {
+	list := make([]*desc.Run, 0, len(runs))
+	for _, run := range runs {
+		list = append(list, rm.ModelToPB(run))
+	}
+
+	return list
+}
+
+// RenderOptionsMapper ...
+type RenderOptionsMapper struct{}
+
+// PBToModel - map options of rendering; YAML is used by default.
+func (rm RenderOptionsMapper) PBToModel(opts *desc.RenderOptions) model.RenderOptions {
+	res := model.RenderOptions{Format: model.ManifestYAML}
+	if opts == nil {
+		return res
+	}
+
+	if opts.Format == desc.RenderOptions_JSON {
+		res.Format = model.ManifestJSON
+	}
+
+	res.ServerDryRun = opts.ServerDryRun
+
+	return res
+}
+
+// RenderedGeneratorMapper ...
+type RenderedGeneratorMapper struct{}
+
+// ModelToPB - map rendered generator to proto-message.
+func (rm RenderedGeneratorMapper) ModelToPB(generator model.RenderedGenerator) *desc.RenderedGenerator {
+	manifests := make([]*desc.Manifest, 0, len(generator.Manifests))
+	for _, manifest := range generator.Manifests {
+		manifests = append(manifests, &desc.Manifest{
+			Kind:    manifest.Kind,
+			Content: manifest.Content,
+		})
+	}
+
+	return &desc.RenderedGenerator{
+		Name:      generator.Name,
+		Manifests: manifests,
+	}
+}
+
+// TemplateMapper ...
+type TemplateMapper struct{}
+
+// ModelToPB - map template to proto-message.
+func (tm TemplateMapper) ModelToPB(template model.Template) *desc.Template {
+	envs := make([]*desc.EnvVar, 0, len(template.Envs))
+	for _, env := range template.Envs {
+		envs = append(envs, EnvVarMapper{}.ModelToPB(env))
+	}
+
+	return &desc.Template{
+		Name:           template.Name,
+		Description:    template.Description,
+		Image:          template.Image,
+		Commands:       template.Commands,
+		Args:           template.Args,
+		WorkingDir:     template.WorkingDir,
+		Ports:          PortMapper{}.ModelToPBMany(template.Ports),
+		ReadinessProbe: ProbeMapper{}.ModelToPB(template.ReadinessProbe),
+		Envs:           envs,
+		Resources: &desc.Resources{
+			Memory: &desc.Resource{Limit: template.Resources.Memory.Limit, Request: template.Resources.Memory.Request},
+			Cpu:    &desc.Resource{Limit: template.Resources.CPU.Limit, Request: template.Resources.CPU.Request},
+		},
+		ExposeExternalIp: template.ExposeExternalIP,
+		Labels:           template.Labels,
+		Annotations:      template.Annotations,
+	}
+}
+
+// ModelToPBMany - map templates to proto-message.
+func (tm TemplateMapper) ModelToPBMany(templates []model.Template) []*desc.Template {
+	list := make([]*desc.Template, 0, len(templates))
+	for _, template := range templates {
+		list = append(list, tm.ModelToPB(template))
+	}
+
+	return list
+}
+
+// ArtifactMapper ...
+type ArtifactMapper struct{}
+
+// ModelToPB - map artifact to proto-message.
+func (am ArtifactMapper) ModelToPB(artifact model.Artifact) *desc.Artifact {
+	return &desc.Artifact{
+		GeneratorName: artifact.Generator,
+		Name:          artifact.Name,
+		Size:          artifact.Size,
+		CreatedAt:     timeToPB(artifact.CreatedAt),
+	}
+}
+
+// ModelToPBMany - map artifacts to proto-message.
+func (am ArtifactMapper) ModelToPBMany(artifacts []model.Artifact) []*desc.Artifact {
+	list := make([]*desc.Artifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		list = append(list, am.ModelToPB(artifact))
+	}
+
+	return list
+}
+
+// QuotaUsageMapper ...
+type QuotaUsageMapper struct{}
+
+// ModelToPB - map usage of quota to proto-message.
+func (qm QuotaUsageMapper) ModelToPB(usage model.QuotaUsage) *desc.QuotaUsage {
+	pb := &desc.QuotaUsage{
+		Team:       usage.Team,
+		Generators: uint32(usage.Generators),
+		Cpu:        usage.CPU,
+		Memory:     usage.Memory,
+	}
+
+	if usage.Quota != nil {
+		pb.Quota = &desc.Quota{
+			MaxGenerators: uint32(usage.Quota.MaxGenerators),
+			Cpu:           usage.Quota.CPU,
+			Memory:        usage.Quota.Memory,
+		}
+	}
+
+	return pb
+}
+
+// ModelToPBMany - map usages of quotas to proto-message.
+func (qm QuotaUsageMapper) ModelToPBMany(usages []model.QuotaUsage) []*desc.QuotaUsage {
+	list := make([]*desc.QuotaUsage, 0, len(usages))
+	for _, usage := range usages {
+		list = append(list, qm.ModelToPB(usage))
+	}
+
+	return list
+}
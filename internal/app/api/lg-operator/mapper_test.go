@@ -286,4 +286,71 @@ func TestEnvVarMapper(t *testing.T) {
 		res := mapper.PbToModelMany(nil)
 		assert.Equal(t, 0, len(res))
 	})
+
+	t.Run("PBToModel references", func(t *testing.T) {
+		res := mapper.PBToModel(&desc.EnvVar{
+			Name:         "TOKEN",
+			Val:          "ignored",
+			SecretKeyRef: &desc.KeySelector{Name: "sut-auth", Key: "token", Optional: true},
+		})
+		assert.Equal(t, model.EnvVar{
+			Name:         "TOKEN",
+			Value:        "ignored",
+			SecretKeyRef: &model.KeyRef{Name: "sut-auth", Key: "token", Optional: true},
+		}, *res)
+
+		res = mapper.PBToModel(&desc.EnvVar{
+			Name:            "TARGET",
+			ConfigMapKeyRef: &desc.KeySelector{Name: "sut", Key: "target"},
+		})
+		assert.Equal(t, model.EnvVar{
+			Name:            "TARGET",
+			ConfigMapKeyRef: &model.KeyRef{Name: "sut", Key: "target"},
+		}, *res)
+	})
+
+	t.Run("EnvFromPBToModelMany ok", func(t *testing.T) {
+		res := mapper.EnvFromPBToModelMany([]*desc.EnvFromSource{
+			{Prefix: "SUT_", Source: &desc.EnvFromSource_SecretName{SecretName: "sut-auth"}},
+			{Source: &desc.EnvFromSource_ConfigMapName{ConfigMapName: "sut"}, Optional: true},
+		})
+		assert.Equal(t, []model.EnvFrom{
+			{Prefix: "SUT_", SecretName: "sut-auth"},
+			{ConfigMapName: "sut", Optional: true},
+		}, res)
+	})
+
+	t.Run("EnvFromPBToModelMany nil", func(t *testing.T) {
+		assert.Nil(t, mapper.EnvFromPBToModelMany(nil))
+	})
+}
+
+func TestProbeMapper(t *testing.T) {
+	mapper := ProbeMapper{}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, mapper.PBToModel(nil))
+		assert.Nil(t, mapper.ModelToPB(nil))
+	})
+
+	for name, probe := range map[string]*desc.Probe{
+		"http get": {
+			Handler:             &desc.Probe_HttpGet{HttpGet: &desc.HTTPGetProbe{Path: "/ping", Port: "api", Scheme: "HTTP"}},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       2,
+		},
+		"tcp socket": {
+			Handler:          &desc.Probe_TcpSocket{TcpSocket: &desc.TCPSocketProbe{Port: "8083"}},
+			FailureThreshold: 10,
+		},
+		"exec": {
+			Handler:        &desc.Probe_Exec{Exec: &desc.ExecProbe{Command: []string{"test", "-f", "/tmp/ready"}}},
+			TimeoutSeconds: 3,
+		},
+	} {
+		probe := probe
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, probe, mapper.ModelToPB(mapper.PBToModel(probe)))
+		})
+	}
 }
@@ -0,0 +1,34 @@
+package lg_operator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	teamNamespacesKey = "kubernetes.team_namespaces"
+)
+
+// namespace - namespace of generator: requested one, or namespace of team of the generator from config.
+// Team is the value of owner label of generator. Empty namespace stands for the default one.
+func (s *Service) namespace(requested string, labels map[string]string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	var teamNamespaces map[string]string
+	if err := s.config.UnmarshalKey(teamNamespacesKey, &teamNamespaces); err != nil {
+		return "", fmt.Errorf("fail to define namespaces of teams: %w", err)
+	}
+
+	if len(teamNamespaces) == 0 {
+		return "", nil
+	}
+
+	quotas, err := s.quotasConfig()
+	if err != nil {
+		return "", err
+	}
+
+	return teamNamespaces[strings.ToLower(labels[quotas.OwnerLabel])], nil
+}
@@ -0,0 +1,110 @@
+package lg_operator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const namespacesConfig = `
+kubernetes:
+  namespace: default
+  namespaces: ['perf-load', 'qa-load']
+  team_namespaces:
+    perf: perf-load
+
+quotas:
+  owner_label: owner
+`
+
+func TestService_namespace(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(namespacesConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Service{config: mngr}
+
+	tests := []struct {
+		name      string
+		requested string
+		labels    map[string]string
+		want      string
+	}{
+		{name: "requested", requested: "qa-load", labels: map[string]string{"owner": "perf"}, want: "qa-load"},
+		{name: "team", labels: map[string]string{"owner": "Perf"}, want: "perf-load"},
+		{name: "team without namespace", labels: map[string]string{"owner": "qa"}, want: ""},
+		{name: "no team", labels: map[string]string{"team": "perf"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, err := s.namespace(tt.requested, tt.labels)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, namespace)
+		})
+	}
+}
+
+func TestService_ClearAll(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(k8sManager, mngr, zaptest.NewLogger(t), ServiceOptions{})
+
+	t.Run("default namespace", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteAll(ctx, "default").Return(nil)
+
+		_, err := s.ClearAll(ctx, &desc.ClearAllRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("requested namespace", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteAll(ctx, "qa-load").Return(nil)
+
+		_, err := s.ClearAll(ctx, &desc.ClearAllRequest{Namespace: "qa-load"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("all namespaces", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteAll(ctx, "").Return(nil)
+
+		_, err := s.ClearAll(ctx, &desc.ClearAllRequest{AllNamespaces: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unmanaged namespace", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteAll(ctx, "kube-system").Return(k8s.ErrInvalidArgument)
+
+		_, err := s.ClearAll(ctx, &desc.ClearAllRequest{Namespace: "kube-system"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("namespace with all namespaces", func(t *testing.T) {
+		_, err := s.ClearAll(ctx, &desc.ClearAllRequest{Namespace: "qa-load", AllNamespaces: true})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
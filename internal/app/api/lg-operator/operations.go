@@ -0,0 +1,34 @@
+package lg_operator
+
+import (
+	"context"
+
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+// GetOperation - get long-running operation by id.
+func (s *Service) GetOperation(_ context.Context, in *desc.GetOperationRequest) (*desc.GetOperationResponse, error) {
+	op, err := s.operations.Get(in.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.GetOperationResponse{Operation: OperationMapper{}.ModelToPB(op)}, nil
+}
+
+// ListOperations - list of long-running operations.
+func (s *Service) ListOperations(_ context.Context, _ *desc.ListOperationsRequest) (*desc.ListOperationsResponse, error) {
+	return &desc.ListOperationsResponse{
+		Operations: OperationMapper{}.ModelToPBMany(s.operations.List()),
+	}, nil
+}
+
+// CancelOperation - cancel long-running operation; generators created so far are deleted in background.
+func (s *Service) CancelOperation(_ context.Context, in *desc.CancelOperationRequest) (*desc.CancelOperationResponse, error) {
+	op, err := s.operations.Cancel(in.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.CancelOperationResponse{Operation: OperationMapper{}.ModelToPB(op)}, nil
+}
@@ -0,0 +1,126 @@
+package lg_operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func waitOperation(t *testing.T, s *Service, id string, st desc.Operation_Status) *desc.Operation {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		res, err := s.GetOperation(context.Background(), &desc.GetOperationRequest{Id: id})
+		assert.NoError(t, err)
+
+		if res.Operation.Status == st {
+			return res.Operation
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("operation has not reached status %s", st)
+
+	return nil
+}
+
+func TestService_Operations(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("async creation", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				cfg.Progress(model.GeneratorProgress{Name: "generator-1", Stage: model.StagePullingImage})
+				return &model.LoadGenerator{Name: "generator-1", Port: 8888, Status: "Running"}, nil
+			})
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage"}},
+			Async:      true,
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.OperationId)
+		assert.Equal(t, 0, len(res.LoadGenerators))
+
+		op := waitOperation(t, s, res.OperationId, desc.Operation_SUCCEEDED)
+		assert.Equal(t, 1, len(op.Generators))
+		assert.Equal(t, "generator-1", op.Generators[0].Name)
+		assert.Equal(t, desc.GeneratorProgress_RUNNING, op.Generators[0].Stage)
+		assert.Equal(t, int32(8888), op.Generators[0].LoadGenerator.Port)
+
+		list, err := s.ListOperations(ctx, &desc.ListOperationsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(list.Operations))
+		assert.Equal(t, res.OperationId, list.Operations[0].Id)
+
+		_, err = s.CancelOperation(ctx, &desc.CancelOperationRequest{Id: res.OperationId})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("cancel with rollback", func(t *testing.T) {
+		created := make(chan struct{})
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ k8s.CreationConfig) (*model.LoadGenerator, error) {
+				defer close(created)
+				return &model.LoadGenerator{Name: "generator-2", Status: "Running"}, nil
+			})
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				cfg.Progress(model.GeneratorProgress{Name: "generator-3", Stage: model.StageScheduled})
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+		k8sManager.EXPECT().Delete(gomock.Any(), "generator-2").Return(nil)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage"}, {Image: "testimage"}},
+			Async:      true,
+		})
+		assert.NoError(t, err)
+
+		<-created
+		cancelRes, err := s.CancelOperation(ctx, &desc.CancelOperationRequest{Id: res.OperationId})
+		assert.NoError(t, err)
+		assert.Equal(t, desc.Operation_CANCELLING, cancelRes.Operation.Status)
+
+		op := waitOperation(t, s, res.OperationId, desc.Operation_CANCELLED)
+		for _, progress := range op.Generators {
+			assert.Equal(t, desc.GeneratorProgress_ROLLED_BACK, progress.Stage)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := s.GetOperation(ctx, &desc.GetOperationRequest{Id: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.CancelOperation(ctx, &desc.CancelOperationRequest{Id: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
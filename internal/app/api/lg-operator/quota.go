@@ -0,0 +1,412 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	quotasKey         = "quotas"
+	defaultOwnerLabel = "team"
+)
+
+var (
+	errQuotaExceeded = errors.New("quota exceeded")
+	errOwnerMissing  = errors.New("owner of generator is missing")
+)
+
+// quotasConfig - quotas section of config.
+/*
+  - OwnerLabel - label of generators with name of their team; "team" by default;
+  - Default - quota of teams without their own quota; such teams are not limited if it is not set;
+  - Teams - quotas by team; names of teams are case-insensitive as config keys.
+*/
+type quotasConfig struct {
+	OwnerLabel string `mapstructure:"owner_label"`
+	Default    *model.Quota
+	Teams      map[string]model.Quota
+}
+
+// quota - quota of team; nil if the team is not limited.
+func (c quotasConfig) quota(team string) *model.Quota {
+	if quota, ok := c.Teams[team]; ok {
+		return &quota
+	}
+
+	return c.Default
+}
+
+// enabled - some teams are limited, so every generator must belong to a team.
+func (c quotasConfig) enabled() bool {
+	return c.Default != nil || len(c.Teams) > 0
+}
+
+// quotaAmount - number of generators and total resources requested by them.
+type quotaAmount struct {
+	generators  int
+	cpu, memory resource.Quantity
+}
+
+func (a *quotaAmount) add(other quotaAmount) {
+	a.generators += other.generators
+	a.cpu.Add(other.cpu)
+	a.memory.Add(other.memory)
+}
+
+func (a *quotaAmount) sub(other quotaAmount) {
+	a.generators -= other.generators
+	a.cpu.Sub(other.cpu)
+	a.memory.Sub(other.memory)
+}
+
+func (a quotaAmount) String() string {
+	return fmt.Sprintf("%d generators, %s cpu, %s memory", a.generators, a.cpu.String(), a.memory.String())
+}
+
+// quotaReservations - amounts of generators being created by teams. Such generators may be not listed
+// by k8s yet, so they count towards quotas of their teams until their creation is finished.
+type quotaReservations struct {
+	// mu - guards teams and serializes checks of quotas.
+	mu    sync.Mutex
+	teams map[string]quotaAmount
+}
+
+func newQuotaReservations() *quotaReservations {
+	return &quotaReservations{teams: make(map[string]quotaAmount)}
+}
+
+// GetQuotaUsage - quotas of teams and resources requested by their pending and running generators.
+func (s *Service) GetQuotaUsage(ctx context.Context, in *desc.GetQuotaUsageRequest) (*desc.GetQuotaUsageResponse, error) {
+	cfg, err := s.quotasConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	s.quotas.mu.Lock()
+	usage, err := s.quotaUsage(ctx, cfg.OwnerLabel)
+	s.quotas.mu.Unlock()
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	var teams []string
+	if in.Team != "" {
+		teams = []string{strings.ToLower(in.Team)}
+	} else {
+		for team := range usage {
+			teams = append(teams, team)
+		}
+
+		for team := range cfg.Teams {
+			if _, ok := usage[team]; !ok {
+				teams = append(teams, team)
+			}
+		}
+
+		sort.Strings(teams)
+	}
+
+	usages := make([]model.QuotaUsage, 0, len(teams))
+	for _, team := range teams {
+		amount := usage[team]
+		usages = append(usages, model.QuotaUsage{
+			Team:       team,
+			Quota:      cfg.quota(team),
+			Generators: amount.generators,
+			CPU:        amount.cpu.String(),
+			Memory:     amount.memory.String(),
+		})
+	}
+
+	return &desc.GetQuotaUsageResponse{Usages: QuotaUsageMapper{}.ModelToPBMany(usages)}, nil
+}
+
+func (s *Service) quotasConfig() (quotasConfig, error) {
+	var cfg quotasConfig
+	if err := s.config.UnmarshalKey(quotasKey, &cfg); err != nil {
+		return quotasConfig{}, fmt.Errorf("fail to define quotas: %w", err)
+	}
+
+	if cfg.OwnerLabel == "" {
+		cfg.OwnerLabel = defaultOwnerLabel
+	}
+
+	return cfg, nil
+}
+
+// quotaShare - amount of a generator being created and its team; empty team if it is not limited.
+type quotaShare struct {
+	team   string
+	amount quotaAmount
+}
+
+// quotaReservation - shares of generators of a creation request reserved in quotas of their teams.
+type quotaReservation struct {
+	quotas *quotaReservations
+	// shares - by index of generator parameters; nil once released.
+	shares []*quotaShare
+}
+
+// release - release share of generator with index i of parameters. Created generator is listed by k8s, so its
+// share is released as soon as its creation is finished, otherwise it would count twice until the whole request ends.
+func (r *quotaReservation) release(i int) {
+	if r == nil {
+		return
+	}
+
+	r.quotas.mu.Lock()
+	defer r.quotas.mu.Unlock()
+
+	r.releaseLocked(i)
+}
+
+// releaseAll - release shares of all generators of the request.
+func (r *quotaReservation) releaseAll() {
+	if r == nil {
+		return
+	}
+
+	r.quotas.mu.Lock()
+	defer r.quotas.mu.Unlock()
+
+	for i := range r.shares {
+		r.releaseLocked(i)
+	}
+}
+
+func (r *quotaReservation) releaseLocked(i int) {
+	if i < 0 || i >= len(r.shares) || r.shares[i] == nil {
+		return
+	}
+
+	share := r.shares[i]
+	r.shares[i] = nil
+
+	reserved := r.quotas.teams[share.team]
+	reserved.sub(share.amount)
+
+	if reserved.generators == 0 {
+		delete(r.quotas.teams, share.team)
+		return
+	}
+
+	r.quotas.teams[share.team] = reserved
+}
+
+// reserveQuota - check that generators of params fit quotas of their teams together with pending and running
+// generators of the teams, and reserve their resources until they are released by the returned reservation.
+// Generators of existing indexes are already created, so they are listed by k8s and are not reserved.
+// Generators of teams without quota are not limited; generators without owner label are rejected if quotas are set.
+func (s *Service) reserveQuota(
+	ctx context.Context,
+	params []*desc.CreateGeneratorsParams,
+	existing map[int]*model.LoadGenerator) (*quotaReservation, error) {
+	reservation := &quotaReservation{quotas: s.quotas, shares: make([]*quotaShare, len(params))}
+
+	cfg, err := s.quotasConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	demand := make(map[string]quotaAmount)
+
+	for i, p := range params {
+		if _, ok := existing[i]; ok {
+			continue
+		}
+
+		share, er := s.quotaShare(p, cfg)
+		if er != nil {
+			return nil, er
+		}
+
+		if share == nil || cfg.quota(share.team) == nil {
+			continue
+		}
+
+		reservation.shares[i] = share
+
+		amount := demand[share.team]
+		amount.add(share.amount)
+		demand[share.team] = amount
+	}
+
+	if len(demand) == 0 {
+		return reservation, nil
+	}
+
+	s.quotas.mu.Lock()
+	defer s.quotas.mu.Unlock()
+
+	usage, err := s.quotaUsage(ctx, cfg.OwnerLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]string, 0, len(demand))
+	for team := range demand {
+		teams = append(teams, team)
+	}
+
+	sort.Strings(teams)
+
+	for _, team := range teams {
+		err = multierr.Append(err, checkQuota(team, *cfg.quota(team), usage[team], demand[team]))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for team, amount := range demand {
+		reserved := s.quotas.teams[team]
+		reserved.add(amount)
+		s.quotas.teams[team] = reserved
+	}
+
+	return reservation, nil
+}
+
+// quotaShare - amount of generator of params and its team; nil if the generator fails to be resolved, since
+// its creation fails anyway, or it has no owner label while quotas are not set. Generator without owner label
+// is rejected if quotas are set, otherwise it would bypass quotas.
+func (s *Service) quotaShare(p *desc.CreateGeneratorsParams, cfg quotasConfig) (*quotaShare, error) {
+	cluster, err := s.cluster(p)
+	if err != nil {
+		return nil, nil
+	}
+
+	// defaults of generator are read from config of its cluster
+	cs := s.inCluster(cluster)
+
+	template, err := cs.template(p.Template)
+	if err != nil {
+		return nil, nil
+	}
+
+	team := strings.ToLower(mergeMaps(template.Labels, p.Labels)[cfg.OwnerLabel])
+	if team == "" {
+		if cfg.enabled() {
+			return nil, fmt.Errorf("%w: generator has no label %s required by quotas", errOwnerMissing, cfg.OwnerLabel)
+		}
+
+		return nil, nil
+	}
+
+	resources, err := cs.resourceMapper.PBToModelWithTemplate(p.Resources, template.Resources)
+	if err != nil {
+		return nil, nil
+	}
+
+	amount := generatorAmount(resources)
+
+	// artifacts sidecar is counted together with generator container, as generatorResources of k8s does
+	if len(cs.artifactsPod.Paths) > 0 {
+		sidecar := generatorAmount(cs.artifactsPod.Resources)
+		sidecar.generators = 0
+		amount.add(sidecar)
+	}
+
+	return &quotaShare{team: team, amount: amount}, nil
+}
+
+// quotaUsage - amounts of pending and running generators and reservations by team.
+// The caller must hold lock of reservations.
+func (s *Service) quotaUsage(ctx context.Context, ownerLabel string) (map[string]quotaAmount, error) {
+	generators, err := s.k8s.List(ctx, model.GeneratorFilter{
+		LabelSelector: ownerLabel,
+		Statuses:      []coreV1.PodPhase{coreV1.PodPending, coreV1.PodRunning},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]quotaAmount)
+
+	for _, generator := range generators {
+		team := strings.ToLower(generator.Labels[ownerLabel])
+
+		amount := usage[team]
+		amount.add(generatorAmount(generator.Resources))
+		usage[team] = amount
+	}
+
+	for team, reserved := range s.quotas.teams {
+		amount := usage[team]
+		amount.add(reserved)
+		usage[team] = amount
+	}
+
+	return usage, nil
+}
+
+// generatorAmount - amount of a generator; limit is requested if request is not set, as k8s does.
+func generatorAmount(resources model.Resources) quotaAmount {
+	quantity := func(r model.Resource) resource.Quantity {
+		val := r.Request
+		if val == "" {
+			val = r.Limit
+		}
+
+		q, err := resource.ParseQuantity(val)
+		if err != nil {
+			return resource.Quantity{}
+		}
+
+		return q
+	}
+
+	return quotaAmount{generators: 1, cpu: quantity(resources.CPU), memory: quantity(resources.Memory)}
+}
+
+// checkQuota - used amount of team together with demanded one must not exceed quota of the team.
+func checkQuota(team string, quota model.Quota, used, demand quotaAmount) error {
+	total := used
+	total.add(demand)
+
+	var exceeded []string
+
+	if quota.MaxGenerators > 0 && total.generators > quota.MaxGenerators {
+		exceeded = append(exceeded, fmt.Sprintf("%d generators", quota.MaxGenerators))
+	}
+
+	for _, limit := range []struct {
+		name  string
+		quota string
+		total resource.Quantity
+	}{
+		{name: "cpu", quota: quota.CPU, total: total.cpu},
+		{name: "memory", quota: quota.Memory, total: total.memory},
+	} {
+		if limit.quota == "" {
+			continue
+		}
+
+		maxQuantity, err := resource.ParseQuantity(limit.quota)
+		if err != nil {
+			return fmt.Errorf("fail to parse %s quota of team %s: %w", limit.name, team, err)
+		}
+
+		if limit.total.Cmp(maxQuantity) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s %s", maxQuantity.String(), limit.name))
+		}
+	}
+
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: team %s requests %s while %s are used, which exceeds quota of %s",
+		errQuotaExceeded, team, demand, used, strings.Join(exceeded, ", "))
+}
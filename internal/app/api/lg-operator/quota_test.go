@@ -0,0 +1,276 @@
+package lg_operator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+const quotasConfigContent = `
+default_resources:
+  cpu:
+    request: 1
+    limit: 2
+  memory:
+    request: 1Gi
+    limit: 2Gi
+
+quotas:
+  owner_label: team
+  teams:
+    perf:
+      max_generators: 3
+      cpu: 4
+      memory: 8Gi
+`
+
+func newQuotaTestService(t *testing.T, k8sManager k8s.Manager) *Service {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(quotasConfigContent), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewService(k8sManager, mngr, zaptest.NewLogger(t), ServiceOptions{})
+}
+
+var runningPerfGenerator = model.LoadGenerator{
+	Name:   "perf-1",
+	Status: coreV1.PodRunning,
+	Labels: map[string]string{"team": "perf"},
+	Resources: model.Resources{
+		CPU:    model.Resource{Request: "2", Limit: "2"},
+		Memory: model.Resource{Request: "2Gi", Limit: "2Gi"},
+	},
+}
+
+var quotaFilter = model.GeneratorFilter{
+	LabelSelector: "team",
+	Statuses:      []coreV1.PodPhase{coreV1.PodPending, coreV1.PodRunning},
+}
+
+func TestService_CreateGenerators_quota(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("within quota", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		k8sManager := mock_k8s.NewMockManager(ctrl)
+		s := newQuotaTestService(t, k8sManager)
+
+		k8sManager.EXPECT().List(ctx, quotaFilter).Return([]model.LoadGenerator{runningPerfGenerator}, nil)
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&model.LoadGenerator{Name: "perf-2"}, nil).Times(2)
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 2, Labels: map[string]string{"team": "perf"}}},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, s.quotas.teams)
+	})
+
+	t.Run("quota exceeded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		k8sManager := mock_k8s.NewMockManager(ctrl)
+		s := newQuotaTestService(t, k8sManager)
+
+		k8sManager.EXPECT().List(ctx, quotaFilter).Return([]model.LoadGenerator{runningPerfGenerator}, nil)
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Image:     "testimage",
+				Replicas:  2,
+				Labels:    map[string]string{"team": "perf"},
+				Resources: &desc.Resources{Cpu: &desc.Resource{Request: "2"}},
+			}},
+		})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), "team perf requests 2 generators, 4 cpu, 2Gi memory while 1 generators, 2 cpu, 2Gi memory are used")
+	})
+
+	t.Run("without owner label", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		k8sManager := mock_k8s.NewMockManager(ctrl)
+		s := newQuotaTestService(t, k8sManager)
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage", Labels: map[string]string{"team": "perf"}},
+				{Image: "testimage"},
+			},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "generator has no label team required by quotas")
+	})
+
+	t.Run("team without quota", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		k8sManager := mock_k8s.NewMockManager(ctrl)
+		s := newQuotaTestService(t, k8sManager)
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&model.LoadGenerator{Name: "qa-1"}, nil).Times(5)
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 5, Labels: map[string]string{"team": "qa"}}},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_reserveQuota(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := newQuotaTestService(t, k8sManager)
+
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return(nil, nil).Times(3)
+
+	params := []*desc.CreateGeneratorsParams{
+		{Image: "testimage", Labels: map[string]string{"team": "perf"}},
+		{Image: "testimage", Labels: map[string]string{"team": "perf"}},
+	}
+
+	reservation, err := s.reserveQuota(ctx, params, nil)
+	assert.NoError(t, err)
+
+	// generators being created count towards quota
+	_, err = s.reserveQuota(ctx, params, nil)
+	assert.ErrorIs(t, err, errQuotaExceeded)
+
+	reservation.releaseAll()
+
+	_, err = s.reserveQuota(ctx, params, nil)
+	assert.NoError(t, err)
+}
+
+func TestService_reserveQuota_releaseCreated(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := newQuotaTestService(t, k8sManager)
+
+	perf := &desc.CreateGeneratorsParams{Image: "testimage", Labels: map[string]string{"team": "perf"}}
+
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return(nil, nil)
+
+	reservation, err := s.reserveQuota(ctx, []*desc.CreateGeneratorsParams{perf, perf}, nil)
+	assert.NoError(t, err)
+
+	// the first generator is created and listed by k8s, so it does not count twice
+	reservation.release(0)
+	reservation.release(0)
+
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return([]model.LoadGenerator{runningPerfGenerator}, nil).Times(2)
+
+	other, err := s.reserveQuota(ctx, []*desc.CreateGeneratorsParams{perf}, nil)
+	assert.NoError(t, err)
+
+	_, err = s.reserveQuota(ctx, []*desc.CreateGeneratorsParams{perf}, nil)
+	assert.ErrorIs(t, err, errQuotaExceeded)
+
+	reservation.releaseAll()
+	other.releaseAll()
+	assert.Empty(t, s.quotas.teams)
+}
+
+func TestService_reserveQuota_existing(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := newQuotaTestService(t, k8sManager)
+
+	perf := &desc.CreateGeneratorsParams{Image: "testimage", Labels: map[string]string{"team": "perf"}}
+
+	// the first generator is created by the previous attempt of idempotent request
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return([]model.LoadGenerator{runningPerfGenerator}, nil)
+
+	reservation, err := s.reserveQuota(ctx, []*desc.CreateGeneratorsParams{perf, perf, perf},
+		map[int]*model.LoadGenerator{0: &runningPerfGenerator})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, s.quotas.teams["perf"].generators)
+
+	reservation.releaseAll()
+	assert.Empty(t, s.quotas.teams)
+}
+
+func TestService_reserveQuota_artifacts(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := newQuotaTestService(t, k8sManager)
+	s.artifactsPod = k8s.ArtifactsConfig{
+		Paths:     []string{"/var/loadtest"},
+		Resources: model.Resources{CPU: model.Resource{Request: "2"}, Memory: model.Resource{Request: "1Gi"}},
+	}
+
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return(nil, nil)
+
+	// 2 generators fit the quota of 4 cpu only without sidecars
+	_, err := s.reserveQuota(ctx, []*desc.CreateGeneratorsParams{
+		{Image: "testimage", Labels: map[string]string{"team": "perf"}},
+		{Image: "testimage", Labels: map[string]string{"team": "perf"}},
+	}, nil)
+	assert.ErrorIs(t, err, errQuotaExceeded)
+	assert.Contains(t, err.Error(), "team perf requests 2 generators, 6 cpu, 4Gi memory")
+}
+
+func TestService_GetQuotaUsage(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	s := newQuotaTestService(t, k8sManager)
+
+	qaGenerator := model.LoadGenerator{
+		Name:      "qa-1",
+		Status:    coreV1.PodPending,
+		Labels:    map[string]string{"team": "qa"},
+		Resources: model.Resources{CPU: model.Resource{Limit: "500m"}, Memory: model.Resource{Request: "512Mi"}},
+	}
+
+	k8sManager.EXPECT().List(ctx, quotaFilter).Return([]model.LoadGenerator{runningPerfGenerator, qaGenerator}, nil).Times(2)
+
+	res, err := s.GetQuotaUsage(ctx, &desc.GetQuotaUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*desc.QuotaUsage{
+		{Team: "perf", Quota: &desc.Quota{MaxGenerators: 3, Cpu: "4", Memory: "8Gi"}, Generators: 1, Cpu: "2", Memory: "2Gi"},
+		{Team: "qa", Generators: 1, Cpu: "500m", Memory: "512Mi"},
+	}, res.Usages)
+
+	res, err = s.GetQuotaUsage(ctx, &desc.GetQuotaUsageRequest{Team: "perf"})
+	assert.NoError(t, err)
+	assert.Len(t, res.Usages, 1)
+	assert.Equal(t, "perf", res.Usages[0].Team)
+}
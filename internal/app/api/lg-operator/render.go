@@ -0,0 +1,49 @@
+package lg_operator
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+// RenderGenerators - render k8s entities of generators without creating them.
+func (s *Service) RenderGenerators(ctx context.Context, in *desc.RenderGeneratorsRequest) (*desc.RenderGeneratorsResponse, error) {
+	params, err := s.expandReplicas(in.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := s.renderGenerators(ctx, params, creationOptions{spreadGroup: uuid.NewString()}, in.Options)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.RenderGeneratorsResponse{Generators: rendered}, nil
+}
+
+// renderGenerators - render generators in order of parameters; parameters are resolved as for creation.
+func (s *Service) renderGenerators(
+	ctx context.Context,
+	params []*desc.CreateGeneratorsParams,
+	opts creationOptions,
+	renderOpts *desc.RenderOptions) ([]*desc.RenderedGenerator, error) {
+	options := RenderOptionsMapper{}.PBToModel(renderOpts)
+	rendered := make([]*desc.RenderedGenerator, 0, len(params))
+
+	for i, p := range params {
+		cfg, err := s.creationConfig(p, generatorMeta{opts, i})
+		if err != nil {
+			return nil, err
+		}
+
+		generator, err := s.k8s.Render(ctx, cfg, options)
+		if err != nil {
+			return nil, err
+		}
+
+		rendered = append(rendered, RenderedGeneratorMapper{}.ModelToPB(*generator))
+	}
+
+	return rendered, nil
+}
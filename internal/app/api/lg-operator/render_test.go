@@ -0,0 +1,89 @@
+package lg_operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_RenderGenerators(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	rendered := &model.RenderedGenerator{
+		Name: "generator",
+		Manifests: []model.Manifest{
+			{Kind: "Pod", Content: "kind: Pod"},
+			{Kind: "Service", Content: "kind: Service"},
+			{Kind: "Ingress", Content: "kind: Ingress"},
+		},
+	}
+
+	t.Run("render with defaults", func(t *testing.T) {
+		k8sManager.EXPECT().Render(ctx, k8s.CreationConfig{
+			Image: "testimage",
+			Resources: model.Resources{
+				Memory: model.Resource{Limit: "2Gi", Request: "1Gi"},
+				CPU:    model.Resource{Limit: "2", Request: "1"},
+			},
+			Envs: []model.EnvVar{},
+		}, model.RenderOptions{Format: model.ManifestJSON, ServerDryRun: true}).Return(rendered, nil)
+
+		res, err := s.RenderGenerators(ctx, &desc.RenderGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage"}},
+			Options:    &desc.RenderOptions{Format: desc.RenderOptions_JSON, ServerDryRun: true},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Generators, 1)
+		assert.Equal(t, "generator", res.Generators[0].Name)
+		assert.Len(t, res.Generators[0].Manifests, 3)
+		assert.Equal(t, "Pod", res.Generators[0].Manifests[0].Kind)
+		assert.Equal(t, "kind: Pod", res.Generators[0].Manifests[0].Content)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		k8sManager.EXPECT().Render(ctx, gomock.Any(), model.RenderOptions{Format: model.ManifestYAML}).
+			Return(rendered, nil).Times(2)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 2}},
+			DryRun:     true,
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, res.LoadGenerators)
+		assert.Len(t, res.RenderedGenerators, 2)
+	})
+
+	t.Run("rejected by k8s", func(t *testing.T) {
+		k8sManager.EXPECT().Render(ctx, gomock.Any(), gomock.Any()).Return(nil, k8s.ErrInvalidArgument)
+
+		res, err := s.RenderGenerators(ctx, &desc.RenderGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage"}},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,89 @@
+package lg_operator
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateRun - create generators labeled with id of a new run.
+func (s *Service) CreateRun(ctx context.Context, in *desc.CreateRunRequest) (*desc.CreateRunResponse, error) {
+	params, err := s.expandReplicas(in.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	run := runMeta{id: uuid.New().String(), name: in.Name}
+
+	reservation, err := s.reserveQuota(ctx, params, nil)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	defer reservation.releaseAll()
+
+	opts := creationOptions{mode: in.Mode, run: run, spreadGroup: uuid.NewString(), reservation: reservation}
+
+	results, err := s.createGenerators(ctx, params, opts, nil)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	created := model.Run{ID: run.id, Name: run.name}
+	for _, result := range results {
+		if result.Generator == nil {
+			continue
+		}
+
+		if created.CreatedAt.IsZero() || result.Generator.CreatedAt.Before(created.CreatedAt) {
+			created.CreatedAt = result.Generator.CreatedAt
+		}
+
+		created.Generators = append(created.Generators, *result.Generator)
+	}
+
+	return &desc.CreateRunResponse{
+		Run:     RunMapper{}.ModelToPB(created),
+		Results: CreationResultMapper{}.ModelToPBMany(results),
+	}, nil
+}
+
+// GetRun - get run by id with its generators.
+func (s *Service) GetRun(ctx context.Context, in *desc.GetRunRequest) (*desc.GetRunResponse, error) {
+	if in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "run id is required")
+	}
+
+	run, err := s.k8s.GetRun(ctx, in.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.GetRunResponse{Run: RunMapper{}.ModelToPB(*run)}, nil
+}
+
+// ListRuns - list of runs with their generators.
+func (s *Service) ListRuns(ctx context.Context, _ *desc.ListRunsRequest) (*desc.ListRunsResponse, error) {
+	runs, err := s.k8s.ListRuns(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.ListRunsResponse{Runs: RunMapper{}.ModelToPBMany(runs)}, nil
+}
+
+// DeleteRun - delete all generators of run.
+func (s *Service) DeleteRun(ctx context.Context, in *desc.DeleteRunRequest) (*desc.DeleteRunResponse, error) {
+	if in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "run id is required")
+	}
+
+	if err := s.k8s.DeleteRun(ctx, in.Id); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &desc.DeleteRunResponse{}, nil
+}
@@ -0,0 +1,126 @@
+package lg_operator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func TestService_Runs(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("create", func(t *testing.T) {
+		runIDs := make(chan string, 2)
+
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.NotEmpty(t, cfg.RunID)
+				assert.Equal(t, "smoke", cfg.RunName)
+				runIDs <- cfg.RunID
+
+				return &model.LoadGenerator{Name: fmt.Sprintf("generator-%d", cfg.Index), Status: coreV1.PodRunning}, nil
+			}).Times(2)
+
+		res, err := s.CreateRun(ctx, &desc.CreateRunRequest{
+			Name: "smoke",
+			Parameters: []*desc.CreateGeneratorsParams{
+				{Image: "testimage"},
+				{Image: "testimage"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, <-runIDs, res.Run.Id)
+		assert.Equal(t, res.Run.Id, <-runIDs)
+		assert.Equal(t, "smoke", res.Run.Name)
+		assert.Equal(t, desc.Run_RUNNING, res.Run.Status)
+		assert.Len(t, res.Run.LoadGenerators, 2)
+		assert.Len(t, res.Results, 2)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		createdAt := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+
+		k8sManager.EXPECT().GetRun(ctx, "run").Return(&model.Run{
+			ID:        "run",
+			CreatedAt: createdAt,
+			Generators: []model.LoadGenerator{
+				{Name: "generator-1", Status: coreV1.PodRunning},
+				{Name: "generator-2", Status: coreV1.PodFailed},
+			},
+		}, nil)
+
+		res, err := s.GetRun(ctx, &desc.GetRunRequest{Id: "run"})
+		assert.NoError(t, err)
+		assert.Equal(t, "run", res.Run.Id)
+		assert.Equal(t, desc.Run_PARTIALLY_FAILED, res.Run.Status)
+		assert.Equal(t, createdAt, res.Run.CreatedAt.AsTime())
+		assert.Len(t, res.Run.LoadGenerators, 2)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		k8sManager.EXPECT().GetRun(ctx, "run").Return(nil, k8s.ErrRunNotFound)
+
+		res, err := s.GetRun(ctx, &desc.GetRunRequest{Id: "run"})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		k8sManager.EXPECT().ListRuns(ctx).Return([]model.Run{
+			{ID: "run-2", Generators: []model.LoadGenerator{{Name: "generator-2", Status: coreV1.PodSucceeded}}},
+			{ID: "run-1", Generators: []model.LoadGenerator{{Name: "generator-1", Status: coreV1.PodPending}}},
+		}, nil)
+
+		res, err := s.ListRuns(ctx, &desc.ListRunsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, res.Runs, 2)
+		assert.Equal(t, desc.Run_COMPLETED, res.Runs[0].Status)
+		assert.Equal(t, desc.Run_PENDING, res.Runs[1].Status)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteRun(ctx, "run").Return(nil)
+
+		_, err := s.DeleteRun(ctx, &desc.DeleteRunRequest{Id: "run"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete unknown run", func(t *testing.T) {
+		k8sManager.EXPECT().DeleteRun(ctx, "unknown").Return(fmt.Errorf("%w: unknown", k8s.ErrRunNotFound))
+
+		_, err := s.DeleteRun(ctx, &desc.DeleteRunRequest{Id: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("delete without id", func(t *testing.T) {
+		_, err := s.DeleteRun(ctx, &desc.DeleteRunRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,41 @@
+package lg_operator
+
+import (
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+const (
+	schedulingKey = "scheduling"
+)
+
+// scheduling - constraints of request merged with defaults from config: node selectors are merged
+// with values of request taking precedence, tolerations are added to default ones,
+// and affinity and spread of request replace default ones if set.
+func (s *Service) scheduling(in *desc.CreateGeneratorsParams) (model.Scheduling, error) {
+	var defaults model.Scheduling
+	if err := s.config.UnmarshalKey(schedulingKey, &defaults); err != nil {
+		return model.Scheduling{}, fmt.Errorf("fail to define default scheduling: %w", err)
+	}
+
+	requested := SchedulingMapper{}.PBToModel(in)
+
+	scheduling := model.Scheduling{
+		NodeSelector: mergeMaps(defaults.NodeSelector, requested.NodeSelector),
+		Tolerations:  append(defaults.Tolerations, requested.Tolerations...),
+		Affinity:     defaults.Affinity,
+		Spread:       defaults.Spread,
+	}
+
+	if requested.Affinity != nil {
+		scheduling.Affinity = requested.Affinity
+	}
+
+	if requested.Spread != model.SpreadNone {
+		scheduling.Spread = requested.Spread
+	}
+
+	return scheduling, nil
+}
@@ -0,0 +1,117 @@
+package lg_operator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+const schedulingConfig = `
+scheduling:
+  node_selector:
+    pool: load-testing
+  tolerations:
+    - key: dedicated
+      operator: Equal
+      value: load-testing
+      effect: NoSchedule
+  affinity:
+    required:
+      - key: node.kubernetes.io/instance-type
+        operator: In
+        values: ['c5.4xlarge']
+`
+
+func TestService_scheduling(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(schedulingConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mngr, err := config.NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Service{config: mngr}
+
+	t.Run("defaults", func(t *testing.T) {
+		scheduling, err := s.scheduling(&desc.CreateGeneratorsParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, model.Scheduling{
+			NodeSelector: map[string]string{"pool": "load-testing"},
+			Tolerations:  []model.Toleration{{Key: "dedicated", Operator: "Equal", Value: "load-testing", Effect: "NoSchedule"}},
+			Affinity: &model.Affinity{Required: []model.NodeSelectorRequirement{
+				{Key: "node.kubernetes.io/instance-type", Operator: "In", Values: []string{"c5.4xlarge"}},
+			}},
+		}, scheduling)
+	})
+
+	t.Run("merged with request", func(t *testing.T) {
+		scheduling, err := s.scheduling(&desc.CreateGeneratorsParams{
+			NodeSelector: map[string]string{"pool": "tanks", "disk": "ssd"},
+			Tolerations:  []*desc.Toleration{{Key: "spot", Operator: "Exists", TolerationSeconds: 60}},
+			Affinity: &desc.Affinity{Preferred: []*desc.PreferredNodeSelectorRequirement{{
+				Weight:      10,
+				Requirement: &desc.NodeSelectorRequirement{Key: "topology.kubernetes.io/zone", Operator: "In", Values: []string{"a"}},
+			}}},
+			Spread: desc.Spread_PER_NODE,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, model.Scheduling{
+			NodeSelector: map[string]string{"pool": "tanks", "disk": "ssd"},
+			Tolerations: []model.Toleration{
+				{Key: "dedicated", Operator: "Equal", Value: "load-testing", Effect: "NoSchedule"},
+				{Key: "spot", Operator: "Exists", TolerationSeconds: 60},
+			},
+			Affinity: &model.Affinity{Preferred: []model.PreferredNodeSelectorRequirement{{
+				Weight:      10,
+				Requirement: model.NodeSelectorRequirement{Key: "topology.kubernetes.io/zone", Operator: "In", Values: []string{"a"}},
+			}}},
+			Spread: model.SpreadPerNode,
+		}, scheduling)
+	})
+}
+
+func TestService_CreateGenerators_spread(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(k8sManager, mngr, zaptest.NewLogger(t), ServiceOptions{})
+
+	groups := make(chan string, 3)
+	k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+			assert.Equal(t, model.SpreadPerZone, cfg.Scheduling.Spread)
+			groups <- cfg.SpreadGroup
+			return &model.LoadGenerator{Name: "testname"}, nil
+		}).Times(3)
+
+	_, err = s.CreateGenerators(context.Background(), &desc.CreateGeneratorsRequest{
+		Parameters: []*desc.CreateGeneratorsParams{{Image: "testimage", Replicas: 3, Spread: desc.Spread_PER_ZONE}},
+	})
+	assert.NoError(t, err)
+	close(groups)
+
+	first := <-groups
+	assert.NotEmpty(t, first)
+	for group := range groups {
+		assert.Equal(t, first, group)
+	}
+}
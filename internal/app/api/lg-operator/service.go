@@ -2,13 +2,29 @@ package lg_operator
 
 import (
 	"context"
+	"time"
 
+	"github.com/spirt-t/lg-operator/internal/ammo"
+	"github.com/spirt-t/lg-operator/internal/artifact"
 	"github.com/spirt-t/lg-operator/internal/config"
 	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/operation"
 	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
 	"go.uber.org/zap"
 )
 
+const (
+	operationsRetentionKey     = "operations.retention"
+	defaultOperationsRetention = time.Hour
+	maxReplicasKey             = "creation.max_replicas"
+	defaultMaxReplicas         = 100
+	creationConcurrencyKey     = "creation.concurrency"
+	defaultCreationConcurrency = 10
+	maxTTLKey                  = "creation.max_ttl"
+	sessionLeaseKey            = "leases.session_duration"
+	defaultSessionLease        = time.Minute
+)
+
 // Service - load-generator service implementation.
 type Service struct {
 	desc.UnimplementedLoadGeneratorOperatorServiceServer
@@ -17,6 +33,23 @@ type Service struct {
 	logger         *zap.Logger
 	resourceMapper *ResourceMapper
 	cleaners       []Cleaner
+	operations     *operation.Storage
+	// maxReplicas - maximum number of replicas of generator parameters.
+	maxReplicas int
+	// creationConcurrency - maximum number of generators created simultaneously by a request.
+	creationConcurrency int
+	// maxTTL - maximum ttl of generator; unlimited if zero.
+	maxTTL time.Duration
+	// sessionLease - lease duration of generators held by HoldLease; renewed at half of it.
+	sessionLease time.Duration
+	// artifacts - store of collected artifacts; nil if collection is disabled.
+	artifacts artifact.Store
+	// artifactsPod - artifact paths kept by sidecar of generator pods.
+	artifactsPod k8s.ArtifactsConfig
+	// ammoStorage - storage of uploaded ammo; nil if it is disabled.
+	ammoStorage *ammo.Storage
+	// quotas - resources of generators being created by teams.
+	quotas *quotaReservations
 }
 
 //go:generate mockgen -source=./service.go -destination=./mock/service.go
@@ -26,15 +59,95 @@ type Cleaner interface {
 	Run(ctx context.Context) error
 }
 
+// ServiceOptions - optional dependencies of Service.
+/*
+  - Cleaners - cleaners of generators run by the service;
+  - Artifacts - store of collected artifacts; nil if collection is disabled;
+  - AmmoStorage - storage of uploaded ammo; nil if it is disabled.
+*/
+type ServiceOptions struct {
+	Cleaners    []Cleaner
+	Artifacts   artifact.Store
+	AmmoStorage *ammo.Storage
+}
+
 // NewService - constructor for Service.
-func NewService(k8s k8s.Manager, config config.Manager, lg *zap.Logger, cleaners []Cleaner) *Service {
+func NewService(k8s k8s.Manager, config config.Manager, lg *zap.Logger, opts ServiceOptions) *Service {
 	return &Service{
 		k8s:            k8s,
 		config:         config,
 		logger:         lg,
 		resourceMapper: NewResourceMapper(config),
-		cleaners:       cleaners,
+		cleaners:       opts.Cleaners,
+		operations:     operation.NewStorage(operationsRetention(config, lg)),
+
+		maxReplicas:         positiveInt(config, maxReplicasKey, defaultMaxReplicas),
+		creationConcurrency: positiveInt(config, creationConcurrencyKey, defaultCreationConcurrency),
+		maxTTL:              maxTTL(config, lg),
+		sessionLease:        sessionLease(config, lg),
+		artifacts:           opts.Artifacts,
+		artifactsPod:        artifact.PodConfig(config),
+		ammoStorage:         opts.AmmoStorage,
+		quotas:              newQuotaReservations(),
+	}
+}
+
+// positiveInt - positive integer from config; default value is used if it is not set.
+func positiveInt(config config.Manager, key string, def int) int {
+	var val int
+	if err := config.UnmarshalKey(key, &val); err != nil || val <= 0 {
+		return def
+	}
+
+	return val
+}
+
+// operationsRetention - how long finished operations are kept.
+func operationsRetention(config config.Manager, lg *zap.Logger) time.Duration {
+	var retentionStr string
+	if err := config.UnmarshalKey(operationsRetentionKey, &retentionStr); err != nil || retentionStr == "" {
+		return defaultOperationsRetention
+	}
+
+	retention, err := time.ParseDuration(retentionStr)
+	if err != nil {
+		lg.Warn("fail to parse operations retention, default is used", zap.Error(err))
+		return defaultOperationsRetention
 	}
+
+	return retention
+}
+
+// maxTTL - maximum ttl of generator; unlimited if it is not set.
+func maxTTL(config config.Manager, lg *zap.Logger) time.Duration {
+	var ttlStr string
+	if err := config.UnmarshalKey(maxTTLKey, &ttlStr); err != nil || ttlStr == "" {
+		return 0
+	}
+
+	ttl, err := time.ParseDuration(ttlStr)
+	if err != nil || ttl < 0 {
+		lg.Warn("fail to parse max ttl of generators, ttl is unlimited", zap.Error(err), zap.String("max_ttl", ttlStr))
+		return 0
+	}
+
+	return ttl
+}
+
+// sessionLease - lease duration of generators held by session.
+func sessionLease(config config.Manager, lg *zap.Logger) time.Duration {
+	var leaseStr string
+	if err := config.UnmarshalKey(sessionLeaseKey, &leaseStr); err != nil || leaseStr == "" {
+		return defaultSessionLease
+	}
+
+	lease, err := time.ParseDuration(leaseStr)
+	if err != nil || lease <= 0 {
+		lg.Warn("fail to parse session lease duration, default is used", zap.Error(err), zap.String("session_duration", leaseStr))
+		return defaultSessionLease
+	}
+
+	return lease
 }
 
 // RunCleaning ...
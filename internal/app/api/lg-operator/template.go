@@ -0,0 +1,117 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+const (
+	templatesKey = "templates"
+)
+
+var (
+	errUnknownTemplate = errors.New("unknown template")
+)
+
+// ListTemplates - list of templates of generator parameters defined in config.
+func (s *Service) ListTemplates(_ context.Context, _ *desc.ListTemplatesRequest) (*desc.ListTemplatesResponse, error) {
+	templates, err := s.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]model.Template, 0, len(templates))
+	for _, template := range templates {
+		list = append(list, template)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return &desc.ListTemplatesResponse{Templates: TemplateMapper{}.ModelToPBMany(list)}, nil
+}
+
+// templates - templates from config by name; names are case-insensitive as config keys.
+func (s *Service) templates() (map[string]model.Template, error) {
+	var templates map[string]model.Template
+	if err := s.config.UnmarshalKey(templatesKey, &templates); err != nil {
+		return nil, fmt.Errorf("fail to define templates: %w", err)
+	}
+
+	for name, template := range templates {
+		template.Name = name
+		templates[name] = template
+	}
+
+	return templates, nil
+}
+
+// template - template by name; empty template is returned for empty name.
+func (s *Service) template(name string) (model.Template, error) {
+	if name == "" {
+		return model.Template{}, nil
+	}
+
+	templates, err := s.templates()
+	if err != nil {
+		return model.Template{}, err
+	}
+
+	template, ok := templates[strings.ToLower(name)]
+	if !ok {
+		return model.Template{}, fmt.Errorf("%w: %s", errUnknownTemplate, name)
+	}
+
+	return template, nil
+}
+
+// mergeEnvs - variables of request override variables of template with the same names.
+func mergeEnvs(template, request []model.EnvVar) []model.EnvVar {
+	if len(template) == 0 {
+		return request
+	}
+
+	envs := make([]model.EnvVar, 0, len(template)+len(request))
+	index := make(map[string]int, len(template))
+
+	for _, env := range template {
+		index[env.Name] = len(envs)
+		envs = append(envs, env)
+	}
+
+	for _, env := range request {
+		if i, ok := index[env.Name]; ok {
+			envs[i] = env
+			continue
+		}
+
+		envs = append(envs, env)
+	}
+
+	return envs
+}
+
+// mergeMaps - values of request override values of template with the same keys.
+func mergeMaps(template, request map[string]string) map[string]string {
+	if len(template) == 0 {
+		return request
+	}
+
+	merged := make(map[string]string, len(template)+len(request))
+	for k, v := range template {
+		merged[k] = v
+	}
+
+	for k, v := range request {
+		merged[k] = v
+	}
+
+	return merged
+}
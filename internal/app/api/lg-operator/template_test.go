@@ -0,0 +1,100 @@
+package lg_operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_Templates(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("list", func(t *testing.T) {
+		res, err := s.ListTemplates(ctx, &desc.ListTemplatesRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, res.Templates, 1)
+		assert.Equal(t, "tank", res.Templates[0].Name)
+		assert.Equal(t, "tankimage", res.Templates[0].Image)
+		assert.Equal(t, "3", res.Templates[0].Resources.Cpu.Limit)
+		assert.True(t, res.Templates[0].ExposeExternalIp)
+	})
+
+	t.Run("create with overrides", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), k8s.CreationConfig{
+			Image: "tankimage",
+			Resources: model.Resources{
+				Memory: model.Resource{Limit: "2Gi", Request: "1Gi"},
+				CPU:    model.Resource{Limit: "3", Request: "2"},
+			},
+			Envs: []model.EnvVar{
+				{Name: "first", Value: "template"},
+				{Name: "second", Value: "request"},
+				{Name: "third", Value: "request"},
+			},
+			Commands:         []string{"run"},
+			ExposeExternalIP: true,
+			Labels:           map[string]string{"tool": "tank", "team": "perf"},
+		}).Return(&model.LoadGenerator{Name: "generator"}, nil)
+
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{
+				Template:  "Tank",
+				Resources: &desc.Resources{Cpu: &desc.Resource{Request: "2"}},
+				AdditionalEnvs: []*desc.EnvVar{
+					{Name: "second", Val: "request"},
+					{Name: "third", Val: "request"},
+				},
+				Labels: map[string]string{"team": "perf"},
+			}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "generator", res.LoadGenerators[0].Name)
+	})
+
+	t.Run("turn off external ip of template", func(t *testing.T) {
+		k8sManager.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
+				assert.False(t, cfg.ExposeExternalIP)
+				return &model.LoadGenerator{Name: "generator"}, nil
+			})
+
+		exposeExternalIP := false
+
+		_, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Template: "tank", ExposeExternalIp: &exposeExternalIP}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		res, err := s.CreateGenerators(ctx, &desc.CreateGeneratorsRequest{
+			Parameters: []*desc.CreateGeneratorsParams{{Template: "unknown"}},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
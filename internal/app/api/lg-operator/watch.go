@@ -0,0 +1,22 @@
+package lg_operator
+
+import (
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+)
+
+// WatchGenerators - stream changes of generators.
+func (s *Service) WatchGenerators(in *desc.WatchGeneratorsRequest, stream desc.LoadGeneratorOperatorService_WatchGeneratorsServer) error {
+	ctx := stream.Context()
+
+	err := s.k8s.Watch(ctx, in.ResourceVersion, func(event model.GeneratorEvent) error {
+		return stream.Send(GeneratorEventMapper{}.ModelToPB(event))
+	})
+
+	if ctx.Err() != nil {
+		// client has gone away
+		return nil
+	}
+
+	return statusError(err)
+}
@@ -0,0 +1,115 @@
+package lg_operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	desc "github.com/spirt-t/lg-operator/pkg/lg-operator"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*desc.WatchGeneratorsResponse
+}
+
+func (ws *watchStream) Context() context.Context {
+	return ws.ctx
+}
+
+func (ws *watchStream) Send(event *desc.WatchGeneratorsResponse) error {
+	ws.events = append(ws.events, event)
+	return nil
+}
+
+func TestService_WatchGenerators(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewService(k8sManager, mngr, l, ServiceOptions{})
+
+	t.Run("ok", func(t *testing.T) {
+		stream := &watchStream{ctx: ctx}
+
+		k8sManager.EXPECT().Watch(ctx, "", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, handle func(model.GeneratorEvent) error) error {
+				for _, event := range []model.GeneratorEvent{
+					{
+						Type:            model.GeneratorAdded,
+						Generator:       model.LoadGenerator{Name: "generator-1", Status: coreV1.PodPending},
+						ResourceVersion: "10",
+					},
+					{
+						Type:            model.GeneratorModified,
+						Generator:       model.LoadGenerator{Name: "generator-1", Status: coreV1.PodRunning},
+						ResourceVersion: "11",
+					},
+					{
+						Type:            model.GeneratorDeleted,
+						Generator:       model.LoadGenerator{Name: "generator-1", Status: coreV1.PodSucceeded},
+						ResourceVersion: "12",
+					},
+				} {
+					if err := handle(event); err != nil {
+						return err
+					}
+				}
+				return errors.New("watch failed")
+			})
+
+		err = s.WatchGenerators(&desc.WatchGeneratorsRequest{}, stream)
+		assert.NotNil(t, err)
+		assert.Equal(t, 3, len(stream.events))
+		assert.Equal(t, desc.WatchGeneratorsResponse_ADDED, stream.events[0].Type)
+		assert.Equal(t, "Pending", stream.events[0].LoadGenerator.Status)
+		assert.Equal(t, desc.WatchGeneratorsResponse_MODIFIED, stream.events[1].Type)
+		assert.Equal(t, "Running", stream.events[1].LoadGenerator.Status)
+		assert.Equal(t, desc.WatchGeneratorsResponse_DELETED, stream.events[2].Type)
+		assert.Equal(t, "12", stream.events[2].ResourceVersion)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		stream := &watchStream{ctx: ctx}
+
+		k8sManager.EXPECT().Watch(ctx, "12", gomock.Any()).Return(fmt.Errorf("%w: 12", k8s.ErrResourceVersionExpired))
+
+		err = s.WatchGenerators(&desc.WatchGeneratorsRequest{ResourceVersion: "12"}, stream)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Equal(t, 0, len(stream.events))
+	})
+
+	t.Run("client gone", func(t *testing.T) {
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		streamCancel()
+		stream := &watchStream{ctx: streamCtx}
+
+		k8sManager.EXPECT().Watch(streamCtx, "", gomock.Any()).Return(context.Canceled)
+
+		err = s.WatchGenerators(&desc.WatchGeneratorsRequest{}, stream)
+		assert.NoError(t, err)
+	})
+}
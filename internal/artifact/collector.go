@@ -0,0 +1,75 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/zap"
+)
+
+const (
+	timeoutKey     = "artifacts.timeout"
+	defaultTimeout = time.Minute
+
+	// archiveNameLayout - artifacts are named by time of collection with nanoseconds,
+	// so collections of generator do not overwrite each other.
+	archiveNameLayout = "20060102T150405.000000000Z.tar.gz"
+)
+
+// Collector - collect artifacts of generators to store.
+type Collector struct {
+	k8s     k8s.Manager
+	store   Store
+	timeout time.Duration
+	// expected - artifact paths are configured, so generators are expected to have artifacts.
+	expected bool
+}
+
+// NewCollector constructor for Collector.
+func NewCollector(config config.Manager, k8s k8s.Manager, store Store, logger *zap.Logger) *Collector {
+	return &Collector{
+		k8s:      k8s,
+		store:    store,
+		timeout:  collectionTimeout(config, logger),
+		expected: len(PodConfig(config).Paths) > 0,
+	}
+}
+
+func collectionTimeout(config config.Manager, logger *zap.Logger) time.Duration {
+	var timeoutStr string
+	if err := config.UnmarshalKey(timeoutKey, &timeoutStr); err != nil || timeoutStr == "" {
+		return defaultTimeout
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil {
+		logger.Warn("fail to parse artifacts collection timeout, default is used", zap.Error(err))
+		return defaultTimeout
+	}
+
+	return timeout
+}
+
+// Collect archive of artifact paths of generator to store.
+// ctx of caller may be already done, e.g. on rollback of creation, so it is not used like in deletion.
+func (c *Collector) Collect(name string) (model.Artifact, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	archive, err := c.k8s.Archive(ctx, name)
+	if err != nil {
+		return model.Artifact{}, err
+	}
+	defer archive.Close()
+
+	artifact, err := c.store.Put(ctx, name, time.Now().UTC().Format(archiveNameLayout), archive)
+	if err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to store artifacts of generator %s: %w", name, err)
+	}
+
+	return artifact, nil
+}
@@ -0,0 +1,174 @@
+package artifact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+)
+
+type localConfig struct {
+	Dir string
+}
+
+// LocalStore - store of artifacts in directory of local filesystem: <dir>/<generator>/<name>.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore constructor for LocalStore; the directory is created if it does not exist.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, errors.New("directory of local artifact store is not set")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("fail to create directory of artifact store: %w", err)
+	}
+
+	return &LocalStore{dir: dir}, nil
+}
+
+// Put artifact; it is written to temporary file first, so incomplete artifacts are never listed.
+func (s *LocalStore) Put(_ context.Context, generator, name string, archive io.Reader) (model.Artifact, error) {
+	if err := validateName(generator); err != nil {
+		return model.Artifact{}, err
+	}
+
+	if err := validateName(name); err != nil {
+		return model.Artifact{}, err
+	}
+
+	dir := filepath.Join(s.dir, generator)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to create directory of generator artifacts: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to create artifact file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, archive); err != nil {
+		_ = tmp.Close()
+		return model.Artifact{}, fmt.Errorf("fail to write artifact: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to write artifact: %w", err)
+	}
+
+	path := filepath.Join(dir, name)
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to save artifact: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to save artifact: %w", err)
+	}
+
+	return makeLocalArtifact(generator, info), nil
+}
+
+// List artifacts of generator or of all generators.
+func (s *LocalStore) List(_ context.Context, generator string) ([]model.Artifact, error) {
+	generators := []string{generator}
+	if generator == "" {
+		entries, err := os.ReadDir(s.dir)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read artifact store: %w", err)
+		}
+
+		generators = generators[:0]
+		for _, entry := range entries {
+			if entry.IsDir() {
+				generators = append(generators, entry.Name())
+			}
+		}
+	} else if err := validateName(generator); err != nil {
+		return nil, err
+	}
+
+	var artifacts []model.Artifact
+	for _, gen := range generators {
+		entries, err := os.ReadDir(filepath.Join(s.dir, gen))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("fail to read artifacts of generator %s: %w", gen, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || validateName(entry.Name()) != nil || isTemporary(entry.Name()) {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				// removed concurrently
+				continue
+			}
+
+			artifacts = append(artifacts, makeLocalArtifact(gen, info))
+		}
+	}
+
+	sortArtifacts(artifacts)
+
+	return artifacts, nil
+}
+
+// Open artifact for reading.
+func (s *LocalStore) Open(_ context.Context, generator, name string) (io.ReadCloser, error) {
+	if err := validateName(generator); err != nil {
+		return nil, err
+	}
+
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filepath.Join(s.dir, generator, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s/%s", ErrNotFound, generator, name)
+		}
+
+		return nil, fmt.Errorf("fail to open artifact: %w", err)
+	}
+
+	return file, nil
+}
+
+func makeLocalArtifact(generator string, info os.FileInfo) model.Artifact {
+	return model.Artifact{
+		Generator: generator,
+		Name:      info.Name(),
+		Size:      info.Size(),
+		CreatedAt: info.ModTime().UTC(),
+	}
+}
+
+func isTemporary(name string) bool {
+	return strings.HasPrefix(name, ".tmp-")
+}
+
+func sortArtifacts(artifacts []model.Artifact) {
+	sort.Slice(artifacts, func(i, j int) bool {
+		if artifacts[i].Generator != artifacts[j].Generator {
+			return artifacts[i].Generator < artifacts[j].Generator
+		}
+
+		return artifacts[i].Name < artifacts[j].Name
+	})
+}
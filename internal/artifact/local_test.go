@@ -0,0 +1,72 @@
+package artifact
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("put and open", func(t *testing.T) {
+		artifact, err := store.Put(ctx, "generator-1", "first.tar.gz", strings.NewReader("archive"))
+		assert.NoError(t, err)
+		assert.Equal(t, "generator-1", artifact.Generator)
+		assert.Equal(t, "first.tar.gz", artifact.Name)
+		assert.Equal(t, int64(7), artifact.Size)
+
+		file, err := store.Open(ctx, "generator-1", "first.tar.gz")
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "archive", string(content))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		_, err := store.Put(ctx, "generator-2", "second.tar.gz", strings.NewReader("other"))
+		assert.NoError(t, err)
+
+		all, err := store.List(ctx, "")
+		assert.NoError(t, err)
+		if assert.Len(t, all, 2) {
+			assert.Equal(t, "generator-1", all[0].Generator)
+			assert.Equal(t, "generator-2", all[1].Generator)
+		}
+
+		some, err := store.List(ctx, "generator-2")
+		assert.NoError(t, err)
+		if assert.Len(t, some, 1) {
+			assert.Equal(t, "second.tar.gz", some[0].Name)
+		}
+
+		none, err := store.List(ctx, "unknown")
+		assert.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := store.Open(ctx, "generator-1", "unknown.tar.gz")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := store.Put(ctx, "..", "first.tar.gz", strings.NewReader("archive"))
+		assert.ErrorIs(t, err, ErrInvalidName)
+
+		_, err = store.Open(ctx, "generator-1", "../generator-2/second.tar.gz")
+		assert.ErrorIs(t, err, ErrInvalidName)
+	})
+}
@@ -0,0 +1,64 @@
+package artifact
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"go.uber.org/zap"
+)
+
+// CollectingManager - k8s manager collecting artifacts of generators before their deletion,
+// so generators deleted by cleaners or by request keep their results.
+// Generators are deleted even if collection fails.
+type CollectingManager struct {
+	k8s.Manager
+	collector *Collector
+	logger    *zap.Logger
+}
+
+// NewCollectingManager constructor for CollectingManager.
+func NewCollectingManager(manager k8s.Manager, collector *Collector, logger *zap.Logger) *CollectingManager {
+	return &CollectingManager{
+		Manager:   manager,
+		collector: collector,
+		logger:    logger,
+	}
+}
+
+// Delete load generator by name after collection of its artifacts.
+func (m *CollectingManager) Delete(ctx context.Context, name string) error {
+	m.collect(name)
+
+	return m.Manager.Delete(ctx, name)
+}
+
+// DeleteRun deletes generators of run after collection of their artifacts.
+func (m *CollectingManager) DeleteRun(ctx context.Context, id string) error {
+	run, err := m.Manager.GetRun(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, generator := range run.Generators {
+		m.collect(generator.Name)
+	}
+
+	return m.Manager.DeleteRun(ctx, id)
+}
+
+func (m *CollectingManager) collect(name string) {
+	artifact, err := m.collector.Collect(name)
+	switch {
+	case err == nil:
+		m.logger.Info("collected generator artifacts",
+			zap.String("generator_name", name), zap.String("artifact", artifact.Name), zap.Int64("size", artifact.Size))
+	case errors.Is(err, k8s.ErrNoArtifacts) && m.collector.expected:
+		// sidecar is not running, e.g. k8s failed the pod, so its artifacts are lost
+		m.logger.Warn("artifacts of generator are lost", zap.String("generator_name", name), zap.Error(err))
+	case errors.Is(err, k8s.ErrNoArtifacts), errors.Is(err, k8s.ErrNotFound):
+		m.logger.Debug("generator has no artifacts to collect", zap.String("generator_name", name), zap.Error(err))
+	default:
+		m.logger.Warn("fail to collect generator artifacts", zap.String("generator_name", name), zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package artifact
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCollectingManager(t *testing.T) {
+	ctx := context.Background()
+	l := zaptest.NewLogger(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewCollectingManager(k8sManager, &Collector{k8s: k8sManager, store: store, timeout: time.Second}, l)
+
+	t.Run("collect before deletion", func(t *testing.T) {
+		gomock.InOrder(
+			k8sManager.EXPECT().Archive(gomock.Any(), "generator-1").
+				Return(io.NopCloser(strings.NewReader("archive")), nil),
+			k8sManager.EXPECT().Delete(ctx, "generator-1").Return(nil),
+		)
+
+		err := m.Delete(ctx, "generator-1")
+		assert.NoError(t, err)
+
+		artifacts, err := store.List(ctx, "generator-1")
+		assert.NoError(t, err)
+		assert.Len(t, artifacts, 1)
+	})
+
+	t.Run("delete if collection fails", func(t *testing.T) {
+		k8sManager.EXPECT().Archive(gomock.Any(), "generator-2").Return(nil, errors.New("some error"))
+		k8sManager.EXPECT().Delete(ctx, "generator-2").Return(nil)
+
+		err := m.Delete(ctx, "generator-2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("run", func(t *testing.T) {
+		k8sManager.EXPECT().GetRun(ctx, "run-1").Return(&model.Run{
+			ID:         "run-1",
+			Generators: []model.LoadGenerator{{Name: "generator-3"}, {Name: "generator-4"}},
+		}, nil)
+		k8sManager.EXPECT().Archive(gomock.Any(), "generator-3").
+			Return(io.NopCloser(strings.NewReader("archive")), nil)
+		k8sManager.EXPECT().Archive(gomock.Any(), "generator-4").Return(nil, k8s.ErrNoArtifacts)
+		k8sManager.EXPECT().DeleteRun(ctx, "run-1").Return(nil)
+
+		err := m.DeleteRun(ctx, "run-1")
+		assert.NoError(t, err)
+
+		artifacts, err := store.List(ctx, "")
+		assert.NoError(t, err)
+		assert.Len(t, artifacts, 2)
+	})
+
+	t.Run("collect twice", func(t *testing.T) {
+		k8sManager.EXPECT().Archive(gomock.Any(), "generator-5").
+			DoAndReturn(func(context.Context, string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("archive")), nil
+			}).Times(2)
+		k8sManager.EXPECT().Delete(ctx, "generator-5").Return(nil).Times(2)
+
+		assert.NoError(t, m.Delete(ctx, "generator-5"))
+		assert.NoError(t, m.Delete(ctx, "generator-5"))
+
+		// archives collected within a second do not overwrite each other
+		artifacts, err := store.List(ctx, "generator-5")
+		assert.NoError(t, err)
+		assert.Len(t, artifacts, 2)
+	})
+}
@@ -0,0 +1,153 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/spirt-t/lg-operator/internal/model"
+)
+
+// s3Config - connection to S3-compatible storage; credentials are read from AWS environment variables
+// if access key is not set.
+type s3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	Prefix    string
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	UseSSL    bool   `mapstructure:"use_ssl"`
+}
+
+// S3Store - store of artifacts in bucket of S3-compatible storage: <prefix><generator>/<name>.
+type S3Store struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Store constructor for S3Store; the bucket must exist.
+func NewS3Store(ctx context.Context, cfg s3Config) (*S3Store, error) {
+	creds := credentials.NewEnvAWS()
+	if cfg.AccessKey != "" {
+		creds = credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, "")
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to make s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("fail to check bucket %s: %w", cfg.Bucket, err)
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("bucket %s does not exist", cfg.Bucket)
+	}
+
+	return &S3Store{client: client, bucket: cfg.Bucket, prefix: cfg.Prefix}, nil
+}
+
+// Put artifact; object is uploaded by parts as size of archive is unknown.
+func (s *S3Store) Put(ctx context.Context, generator, name string, archive io.Reader) (model.Artifact, error) {
+	if err := validateName(generator); err != nil {
+		return model.Artifact{}, err
+	}
+
+	if err := validateName(name); err != nil {
+		return model.Artifact{}, err
+	}
+
+	info, err := s.client.PutObject(ctx, s.bucket, s.key(generator, name), archive, -1, minio.PutObjectOptions{
+		ContentType: "application/gzip",
+	})
+	if err != nil {
+		return model.Artifact{}, fmt.Errorf("fail to upload artifact: %w", err)
+	}
+
+	return model.Artifact{
+		Generator: generator,
+		Name:      name,
+		Size:      info.Size,
+		CreatedAt: info.LastModified,
+	}, nil
+}
+
+// List artifacts of generator or of all generators.
+func (s *S3Store) List(ctx context.Context, generator string) ([]model.Artifact, error) {
+	prefix := s.prefix
+	if generator != "" {
+		if err := validateName(generator); err != nil {
+			return nil, err
+		}
+
+		prefix = s.key(generator, "")
+	}
+
+	var artifacts []model.Artifact
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("fail to list artifacts: %w", object.Err)
+		}
+
+		gen, name, ok := strings.Cut(strings.TrimPrefix(object.Key, s.prefix), "/")
+		if !ok || validateName(gen) != nil || validateName(name) != nil {
+			continue
+		}
+
+		artifacts = append(artifacts, model.Artifact{
+			Generator: gen,
+			Name:      name,
+			Size:      object.Size,
+			CreatedAt: object.LastModified,
+		})
+	}
+
+	sortArtifacts(artifacts)
+
+	return artifacts, nil
+}
+
+// Open artifact for reading.
+func (s *S3Store) Open(ctx context.Context, generator, name string) (io.ReadCloser, error) {
+	if err := validateName(generator); err != nil {
+		return nil, err
+	}
+
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	object, err := s.client.GetObject(ctx, s.bucket, s.key(generator, name), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fail to download artifact: %w", err)
+	}
+
+	// object is requested lazily, so absence is detected by its stat
+	if _, err = object.Stat(); err != nil {
+		_ = object.Close()
+
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s/%s", ErrNotFound, generator, name)
+		}
+
+		return nil, fmt.Errorf("fail to download artifact: %w", err)
+	}
+
+	return object, nil
+}
+
+func (s *S3Store) key(generator, name string) string {
+	return s.prefix + generator + "/" + name
+}
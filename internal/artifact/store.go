@@ -0,0 +1,134 @@
+package artifact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+)
+
+const (
+	enabledKey   = "artifacts.enabled"
+	pathsKey     = "artifacts.paths"
+	imageKey     = "artifacts.image"
+	defaultImage = "busybox:1.36"
+	resourcesKey = "artifacts.resources"
+	graceKey     = "artifacts.deadline_grace"
+	defaultGrace = 5 * time.Minute
+	storeKey     = "artifacts.store"
+
+	localStoreType = "local"
+	s3StoreType    = "s3"
+)
+
+var (
+	// ErrNotFound - artifact does not exist in store.
+	ErrNotFound = errors.New("artifact not found")
+	// ErrInvalidName - name of generator or artifact can not be used as a key of store.
+	ErrInvalidName = errors.New("invalid artifact name")
+)
+
+// Store - storage of artifacts addressed by generator name and artifact name.
+type Store interface {
+	Put(ctx context.Context, generator, name string, archive io.Reader) (model.Artifact, error)
+	// List artifacts of generator ordered by generator and name; artifacts of all generators if it is empty.
+	List(ctx context.Context, generator string) ([]model.Artifact, error)
+	// Open artifact for reading. The caller must close the returned stream.
+	Open(ctx context.Context, generator, name string) (io.ReadCloser, error)
+}
+
+type storeConfig struct {
+	Type  string
+	Local localConfig
+	S3    s3Config
+}
+
+// Enabled - collection of artifacts is turned on in config.
+func Enabled(config config.Manager) bool {
+	var enabled bool
+	_ = config.UnmarshalKey(enabledKey, &enabled)
+
+	return enabled
+}
+
+// NewStore - store of type defined in config.
+func NewStore(ctx context.Context, config config.Manager) (Store, error) {
+	var cfg storeConfig
+	if err := config.UnmarshalKey(storeKey, &cfg); err != nil {
+		return nil, fmt.Errorf("fail to define artifact store: %w", err)
+	}
+
+	switch cfg.Type {
+	case localStoreType:
+		return NewLocalStore(cfg.Local.Dir)
+	case s3StoreType:
+		return NewS3Store(ctx, cfg.S3)
+	}
+
+	return nil, fmt.Errorf("unknown artifact store type %q", cfg.Type)
+}
+
+// validateName - name must be a single path element, so it can not escape directory of store.
+func validateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+
+	return nil
+}
+
+// defaultResources - resources of sidecar, which only sleeps until archiving.
+var defaultResources = model.Resources{
+	CPU:    model.Resource{Request: "10m", Limit: "100m"},
+	Memory: model.Resource{Request: "16Mi", Limit: "64Mi"},
+}
+
+// PodConfig - artifact paths of generators, image and resources of their sidecar; paths are empty if collection is disabled.
+func PodConfig(config config.Manager) k8s.ArtifactsConfig {
+	if !Enabled(config) {
+		return k8s.ArtifactsConfig{}
+	}
+
+	var cfg k8s.ArtifactsConfig
+	_ = config.UnmarshalKey(pathsKey, &cfg.Paths)
+	_ = config.UnmarshalKey(imageKey, &cfg.Image)
+
+	var resources model.Resources
+	_ = config.UnmarshalKey(resourcesKey, &resources)
+
+	if cfg.Image == "" {
+		cfg.Image = defaultImage
+	}
+
+	cfg.Resources = defaultResources
+	overrideResource(&cfg.Resources.CPU, resources.CPU)
+	overrideResource(&cfg.Resources.Memory, resources.Memory)
+
+	cfg.DeadlineGrace = defaultGrace
+
+	var graceStr string
+	if err := config.UnmarshalKey(graceKey, &graceStr); err == nil && graceStr != "" {
+		if grace, err := time.ParseDuration(graceStr); err == nil && grace > 0 {
+			cfg.DeadlineGrace = grace
+		}
+	}
+
+	return cfg
+}
+
+// overrideResource - set values of resource which are configured.
+func overrideResource(r *model.Resource, configured model.Resource) {
+	if configured.Request != "" {
+		r.Request = configured.Request
+	}
+
+	if configured.Limit != "" {
+		r.Limit = configured.Limit
+	}
+}
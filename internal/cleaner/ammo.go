@@ -0,0 +1,136 @@
+package cleaner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	unusedAmmoCleaningEnabledKey   = "cleaning.ammo.enabled"
+	unusedAmmoCleaningIntervalKey  = "cleaning.ammo.interval"
+	unusedAmmoCleaningRetentionKey = "cleaning.ammo.retention"
+)
+
+// AmmoStorage - storage of uploaded ammo.
+type AmmoStorage interface {
+	List() ([]model.Ammo, error)
+	Delete(id string) error
+}
+
+// UnusedAmmoCleaner - delete ammo which is not mounted into any generator
+// and was not used for retention specified in the config.
+type UnusedAmmoCleaner struct {
+	config  config.Manager
+	k8s     k8s.Manager
+	storage AmmoStorage
+	logger  *zap.Logger
+}
+
+// NewUnusedAmmoCleaner constructor for UnusedAmmoCleaner.
+func NewUnusedAmmoCleaner(config config.Manager, k8s k8s.Manager, storage AmmoStorage, logger *zap.Logger) *UnusedAmmoCleaner {
+	return &UnusedAmmoCleaner{
+		config:  config,
+		k8s:     k8s,
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Run - clean unused ammo regular.
+func (ac *UnusedAmmoCleaner) Run(ctx context.Context) error {
+	for {
+		interval, err := ac.duration(unusedAmmoCleaningIntervalKey)
+		if err != nil {
+			return err
+		}
+
+		if ac.enabled() {
+			if err = ac.regularCleaning(ctx); err != nil {
+				ac.logger.Error("failed to clean unused ammo", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (ac *UnusedAmmoCleaner) enabled() bool {
+	var enabled bool
+	_ = ac.config.UnmarshalKey(unusedAmmoCleaningEnabledKey, &enabled)
+
+	return enabled
+}
+
+func (ac *UnusedAmmoCleaner) duration(key string) (time.Duration, error) {
+	var durationStr string
+	if err := ac.config.UnmarshalKey(key, &durationStr); err != nil {
+		return 0, fmt.Errorf("failed to define %s: %w", key, err)
+	}
+
+	return time.ParseDuration(durationStr)
+}
+
+func (ac *UnusedAmmoCleaner) regularCleaning(ctx context.Context) error {
+	var idsToDelete []string
+
+	ac.logger.Info("Start cleaning unused ammo")
+	defer func() {
+		ac.logger.Info("Deleted unused ammo", zap.String("ammo", strings.Join(idsToDelete, ",")))
+	}()
+
+	retention, err := ac.duration(unusedAmmoCleaningRetentionKey)
+	if err != nil {
+		return err
+	}
+
+	// ammo is listed before generators, so ammo mounted into generator created in between is not lost
+	list, err := ac.storage.List()
+	if err != nil {
+		return err
+	}
+
+	generators, err := ac.k8s.List(ctx, model.GeneratorFilter{})
+	if err != nil {
+		return err
+	}
+
+	idsToDelete = ac.idsToDelete(list, generators, time.Now().Add(-retention))
+	for _, id := range idsToDelete {
+		err = multierr.Append(err, ac.storage.Delete(id))
+	}
+
+	return err
+}
+
+// idsToDelete - ammo not mounted into any of generators and last used before the bound.
+func (ac *UnusedAmmoCleaner) idsToDelete(list []model.Ammo, generators []model.LoadGenerator, usedBefore time.Time) []string {
+	mounted := make(map[string]struct{})
+	for _, generator := range generators {
+		for _, id := range generator.AmmoIDs {
+			mounted[id] = struct{}{}
+		}
+	}
+
+	var idsToDelete []string
+	for _, ammo := range list {
+		if _, ok := mounted[ammo.ID]; ok || !ammo.UsedAt.Before(usedBefore) {
+			continue
+		}
+
+		idsToDelete = append(idsToDelete, ammo.ID)
+	}
+
+	return idsToDelete
+}
@@ -0,0 +1,36 @@
+package cleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ammo_idsToDelete(t *testing.T) {
+	t.Parallel()
+	ac := UnusedAmmoCleaner{}
+	now := time.Now()
+
+	t.Run("unused ammo", func(t *testing.T) {
+		t.Parallel()
+
+		ids := ac.idsToDelete([]model.Ammo{
+			{ID: "mounted", UsedAt: now.Add(-48 * time.Hour)},
+			{ID: "old", UsedAt: now.Add(-48 * time.Hour)},
+			{ID: "recent", UsedAt: now.Add(-time.Hour)},
+		}, []model.LoadGenerator{
+			{Name: "lg-1", AmmoIDs: []string{"mounted"}},
+			{Name: "lg-2"},
+		}, now.Add(-24*time.Hour))
+		assert.Equal(t, []string{"old"}, ids)
+	})
+
+	t.Run("no ammo", func(t *testing.T) {
+		t.Parallel()
+
+		ids := ac.idsToDelete(nil, []model.LoadGenerator{{Name: "lg-1"}}, now)
+		assert.Equal(t, 0, len(ids))
+	})
+}
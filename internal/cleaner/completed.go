@@ -88,7 +88,7 @@ func (rc *CompletedLGCleaner) regularCleaning(ctx context.Context) error {
 		rc.logger.Info("Deleted completed generators", zap.String("generators", strings.Join(namesToDelete, ",")))
 	}()
 
-	generators, err := rc.k8s.List(ctx)
+	generators, err := rc.k8s.List(ctx, model.GeneratorFilter{})
 	if err != nil {
 		return err
 	}
@@ -125,7 +125,7 @@ func Test_completed_regularCleaning(t *testing.T) {
 	rc := CompletedLGCleaner{mngr, k8sManager, l}
 
 	t.Run("with completed pods", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return([]model.LoadGenerator{
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
 			{
 				Name:       "lg-1",
 				ClusterIP:  "127.0.0.1",
@@ -167,7 +167,7 @@ func Test_completed_regularCleaning(t *testing.T) {
 	})
 
 	t.Run("without completed pods", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return([]model.LoadGenerator{
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
 			{
 				Name:       "lg-1",
 				ClusterIP:  "127.0.0.1",
@@ -207,14 +207,14 @@ func Test_completed_regularCleaning(t *testing.T) {
 	})
 
 	t.Run("nil pods list", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return(nil, nil)
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, nil)
 
 		err = rc.regularCleaning(ctx)
 		assert.NoError(t, err)
 	})
 
 	t.Run("error", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return(nil, errors.New("some error"))
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, errors.New("some error"))
 
 		err = rc.regularCleaning(ctx)
 		assert.NotNil(t, err)
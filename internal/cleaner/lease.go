@@ -0,0 +1,117 @@
+package cleaner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	expiredLeaseCleaningEnabledKey  = "cleaning.leases.enabled"
+	expiredLeaseCleaningIntervalKey = "cleaning.leases.interval"
+)
+
+// ExpiredLeaseCleaner - delete generators with lapsed leases at interval specified in the config.
+type ExpiredLeaseCleaner struct {
+	config config.Manager
+	k8s    k8s.Manager
+	logger *zap.Logger
+}
+
+// NewExpiredLeaseCleaner constructor for ExpiredLeaseCleaner.
+func NewExpiredLeaseCleaner(config config.Manager, k8s k8s.Manager, logger *zap.Logger) *ExpiredLeaseCleaner {
+	return &ExpiredLeaseCleaner{
+		config: config,
+		k8s:    k8s,
+		logger: logger,
+	}
+}
+
+// Run - clean generators with expired leases regular.
+func (lc *ExpiredLeaseCleaner) Run(ctx context.Context) error {
+	for {
+		interval, err := lc.interval()
+		if err != nil {
+			return err
+		}
+
+		if lc.enabled() {
+			if err = lc.regularCleaning(ctx); err != nil {
+				lc.logger.Error("failed to clean generators with expired leases", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (lc *ExpiredLeaseCleaner) enabled() bool {
+	var enabled bool
+	_ = lc.config.UnmarshalKey(expiredLeaseCleaningEnabledKey, &enabled)
+
+	return enabled
+}
+
+func (lc *ExpiredLeaseCleaner) interval() (time.Duration, error) {
+	var intervalStr string
+	if err := lc.config.UnmarshalKey(expiredLeaseCleaningIntervalKey, &intervalStr); err != nil {
+		return 0, fmt.Errorf("failed to define interval: %w", err)
+	}
+
+	return time.ParseDuration(intervalStr)
+}
+
+func (lc *ExpiredLeaseCleaner) regularCleaning(ctx context.Context) error {
+	var namesToDelete []string
+
+	lc.logger.Info("Start cleaning generators with expired leases")
+	defer func() {
+		lc.logger.Info("Deleted generators with expired leases", zap.String("generators", strings.Join(namesToDelete, ",")))
+	}()
+
+	generators, err := lc.k8s.List(ctx, model.GeneratorFilter{})
+	if err != nil {
+		return err
+	}
+
+	namesToDelete = lc.namesToDelete(generators, time.Now())
+	if len(namesToDelete) == 0 {
+		return nil
+	}
+
+	ch := make(chan error)
+	for _, name := range namesToDelete {
+		go func(name string) {
+			ch <- lc.k8s.Delete(ctx, name)
+		}(name)
+	}
+
+	for i := 0; i < len(namesToDelete); i++ {
+		err = multierr.Append(err, <-ch)
+	}
+
+	return err
+}
+
+// namesToDelete - generators with leases expired before now; generators without leases are kept.
+func (lc *ExpiredLeaseCleaner) namesToDelete(list []model.LoadGenerator, now time.Time) []string {
+	var namesToDelete []string
+	for _, generator := range list {
+		if !generator.LeaseExpiresAt.IsZero() && generator.LeaseExpiresAt.Before(now) {
+			namesToDelete = append(namesToDelete, generator.Name)
+		}
+	}
+
+	return namesToDelete
+}
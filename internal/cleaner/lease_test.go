@@ -0,0 +1,93 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/config"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func Test_lease_namesToDelete(t *testing.T) {
+	t.Parallel()
+	lc := ExpiredLeaseCleaner{}
+	now := time.Now()
+
+	t.Run("with expired leases", func(t *testing.T) {
+		t.Parallel()
+
+		names := lc.namesToDelete([]model.LoadGenerator{
+			{
+				Name:           "lg-1",
+				Status:         coreV1.PodRunning,
+				LeaseExpiresAt: now.Add(-time.Second),
+			},
+			{
+				Name:           "lg-2",
+				Status:         coreV1.PodRunning,
+				LeaseExpiresAt: now.Add(time.Minute),
+			},
+			{
+				Name:      "lg-3",
+				Status:    coreV1.PodRunning,
+				CreatedAt: now.Add(-30 * time.Hour),
+			},
+			{
+				Name:           "lg-4",
+				Status:         coreV1.PodPending,
+				LeaseExpiresAt: now.Add(-time.Hour),
+			},
+		}, now)
+		assert.Equal(t, []string{"lg-1", "lg-4"}, names)
+	})
+
+	t.Run("nil list", func(t *testing.T) {
+		t.Parallel()
+
+		names := lc.namesToDelete(nil, now)
+		assert.Equal(t, 0, len(names))
+	})
+}
+
+func Test_lease_regularCleaning(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k8sManager := mock_k8s.NewMockManager(ctrl)
+	mngr, err := config.NewManager("../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := zaptest.NewLogger(t)
+
+	lc := NewExpiredLeaseCleaner(mngr, k8sManager, l)
+
+	t.Run("with expired leases", func(t *testing.T) {
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
+			{Name: "lg-1", LeaseExpiresAt: time.Now().Add(-time.Minute)},
+			{Name: "lg-2", LeaseExpiresAt: time.Now().Add(time.Minute)},
+			{Name: "lg-3"},
+		}, nil)
+		k8sManager.EXPECT().Delete(ctx, "lg-1").Return(nil)
+
+		err = lc.regularCleaning(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, errors.New("some error"))
+
+		err = lc.regularCleaning(ctx)
+		assert.NotNil(t, err)
+	})
+}
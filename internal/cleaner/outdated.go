@@ -14,11 +14,17 @@ import (
 )
 
 const (
-	outdatedLGCleaningEnabledKey = "cleaning.outdated.enabled"
-	outdatedLGCleaningTTLKey     = "cleaning.outdated.ttl"
+	outdatedLGCleaningEnabledKey  = "cleaning.outdated.enabled"
+	outdatedLGCleaningTTLKey      = "cleaning.outdated.ttl"
+	outdatedLGCleaningIntervalKey = "cleaning.outdated.interval"
+	// defaultOutdatedInterval - maximum check interval if it is not configured,
+	// so ttl of generators shorter than global one is honored in time.
+	defaultOutdatedInterval = 5 * time.Minute
+	// minOutdatedWait - minimum wait before the next check, so generators whose deletion fails are not retried too often.
+	minOutdatedWait = time.Second
 )
 
-// OutdatedLGCleaner - delete completed generators at interval specified in the config.
+// OutdatedLGCleaner - delete generators older than their ttl or global ttl specified in the config.
 type OutdatedLGCleaner struct {
 	config config.Manager
 	k8s    k8s.Manager
@@ -37,21 +43,39 @@ func NewOutdatedLGCleaner(config config.Manager, k8s k8s.Manager, logger *zap.Lo
 // Run - clean old generators regular.
 func (oc *OutdatedLGCleaner) Run(ctx context.Context) error {
 	for {
-		interval, err := oc.ttl()
+		ttl, err := oc.ttl()
 		if err != nil {
 			return err
 		}
 
+		interval, err := oc.interval(ttl)
+		if err != nil {
+			return err
+		}
+
+		wait := interval
+
 		if oc.enabled() {
-			if err = oc.regularCleaning(ctx, interval); err != nil {
+			next, err := oc.regularCleaning(ctx, ttl)
+			if err != nil {
 				oc.logger.Error("failed to clean old generators", zap.Error(err))
 			}
+
+			// generators are deleted at their ttl rather than at the next interval, since k8s fails
+			// their pods soon after it and artifacts of failed pods can not be collected
+			if untilNext := time.Until(next); !next.IsZero() && untilNext < wait {
+				wait = untilNext
+			}
+		}
+
+		if wait < minOutdatedWait {
+			wait = minOutdatedWait
 		}
 
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-time.After(interval):
+		case <-time.After(wait):
 		}
 	}
 }
@@ -72,7 +96,26 @@ func (oc *OutdatedLGCleaner) ttl() (time.Duration, error) {
 	return time.ParseDuration(intervalStr)
 }
 
-func (oc *OutdatedLGCleaner) regularCleaning(ctx context.Context, ttl time.Duration) error {
+// interval - period of checks; the least of global ttl and default interval is used if it is not set.
+func (oc *OutdatedLGCleaner) interval(ttl time.Duration) (time.Duration, error) {
+	var intervalStr string
+	if err := oc.config.UnmarshalKey(outdatedLGCleaningIntervalKey, &intervalStr); err != nil {
+		return 0, fmt.Errorf("failed to define interval: %w", err)
+	}
+
+	if intervalStr != "" {
+		return time.ParseDuration(intervalStr)
+	}
+
+	if ttl < defaultOutdatedInterval {
+		return ttl, nil
+	}
+
+	return defaultOutdatedInterval, nil
+}
+
+// regularCleaning deletes outdated generators; returns expiration time of the next one, zero if there are none.
+func (oc *OutdatedLGCleaner) regularCleaning(ctx context.Context, ttl time.Duration) (time.Time, error) {
 	var namesToDelete []string
 
 	oc.logger.Info("Start cleaning old generators")
@@ -80,14 +123,16 @@ func (oc *OutdatedLGCleaner) regularCleaning(ctx context.Context, ttl time.Durat
 		oc.logger.Info("Deleted old generators", zap.String("generators", strings.Join(namesToDelete, ",")))
 	}()
 
-	generators, err := oc.k8s.List(ctx)
+	generators, err := oc.k8s.List(ctx, model.GeneratorFilter{})
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	namesToDelete = oc.namesToDelete(generators, ttl)
+	next := nextExpiration(generators, ttl, time.Now().UTC())
+
 	if len(namesToDelete) == 0 {
-		return nil
+		return next, nil
 	}
 
 	ch := make(chan error)
@@ -101,15 +146,38 @@ func (oc *OutdatedLGCleaner) regularCleaning(ctx context.Context, ttl time.Durat
 		err = multierr.Append(err, <-ch)
 	}
 
-	return err
+	return next, err
+}
+
+// expiration - time after which generator is outdated.
+func expiration(generator model.LoadGenerator, ttl time.Duration) time.Time {
+	if generator.TTL > 0 {
+		ttl = generator.TTL
+	}
+
+	return generator.CreatedAt.Add(ttl)
+}
+
+// nextExpiration - the earliest expiration time of generators not outdated at now; zero if there are none.
+func nextExpiration(list []model.LoadGenerator, ttl time.Duration, now time.Time) time.Time {
+	var next time.Time
+	for _, generator := range list {
+		expiresAt := expiration(generator, ttl)
+		if expiresAt.After(now) && (next.IsZero() || expiresAt.Before(next)) {
+			next = expiresAt
+		}
+	}
+
+	return next
 }
 
+// namesToDelete - generators older than their own ttl if it is set, otherwise older than global ttl.
 func (oc *OutdatedLGCleaner) namesToDelete(list []model.LoadGenerator, ttl time.Duration) []string {
-	maxOldTime := time.Now().UTC().Add(-ttl)
+	now := time.Now().UTC()
 
 	var namesToDelete []string
 	for _, generator := range list {
-		if generator.CreatedAt.Before(maxOldTime) {
+		if expiration(generator, ttl).Before(now) {
 			namesToDelete = append(namesToDelete, generator.Name)
 		}
 	}
@@ -100,6 +100,37 @@ func Test_outdated_namesToDelete(t *testing.T) {
 		assert.Equal(t, 0, len(names))
 	})
 
+	t.Run("with ttl of generators", func(t *testing.T) {
+		t.Parallel()
+
+		names := rc.namesToDelete([]model.LoadGenerator{
+			{
+				Name:      "lg-1",
+				Status:    coreV1.PodRunning,
+				TTL:       10 * time.Minute,
+				CreatedAt: time.Now().Add(-15 * time.Minute),
+			},
+			{
+				Name:      "lg-2",
+				Status:    coreV1.PodRunning,
+				TTL:       48 * time.Hour,
+				CreatedAt: time.Now().Add(-30 * time.Hour),
+			},
+			{
+				Name:      "lg-3",
+				Status:    coreV1.PodRunning,
+				TTL:       time.Hour,
+				CreatedAt: time.Now().Add(-30 * time.Minute),
+			},
+			{
+				Name:      "lg-4",
+				Status:    coreV1.PodRunning,
+				CreatedAt: time.Now().Add(-25 * time.Hour),
+			},
+		}, 24*time.Hour)
+		assert.Equal(t, []string{"lg-1", "lg-4"}, names)
+	})
+
 	t.Run("nil list", func(t *testing.T) {
 		t.Parallel()
 
@@ -108,6 +139,38 @@ func Test_outdated_namesToDelete(t *testing.T) {
 	})
 }
 
+func Test_nextExpiration(t *testing.T) {
+	now := time.Now()
+	generators := []model.LoadGenerator{
+		{Name: "lg-1", TTL: 10 * time.Minute, CreatedAt: now.Add(-15 * time.Minute)},
+		{Name: "lg-2", TTL: 10 * time.Minute, CreatedAt: now.Add(-5 * time.Minute)},
+		{Name: "lg-3", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	// outdated generators are skipped
+	assert.Equal(t, now.Add(5*time.Minute), nextExpiration(generators, 24*time.Hour, now))
+	assert.Equal(t, now.Add(time.Minute), nextExpiration(generators, 61*time.Minute, now))
+	assert.True(t, nextExpiration(nil, time.Hour, now).IsZero())
+}
+
+func Test_outdated_interval(t *testing.T) {
+	mngr, err := config.NewManager("../../testfiles/test_config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rc := OutdatedLGCleaner{config: mngr}
+
+	// interval is not set in test config
+	interval, err := rc.interval(24 * time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultOutdatedInterval, interval)
+
+	interval, err = rc.interval(time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, interval)
+}
+
 func Test_outdated_regularCleaning(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -125,7 +188,7 @@ func Test_outdated_regularCleaning(t *testing.T) {
 	rc := OutdatedLGCleaner{mngr, k8sManager, l}
 
 	t.Run("with completed pods", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return([]model.LoadGenerator{
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
 			{
 				Name:       "lg-1",
 				ClusterIP:  "127.0.0.1",
@@ -162,12 +225,12 @@ func Test_outdated_regularCleaning(t *testing.T) {
 		k8sManager.EXPECT().Delete(ctx, "lg-1").Return(nil)
 		k8sManager.EXPECT().Delete(ctx, "lg-4").Return(nil)
 
-		err = rc.regularCleaning(ctx, 24*time.Hour)
+		_, err = rc.regularCleaning(ctx, 24*time.Hour)
 		assert.NoError(t, err)
 	})
 
 	t.Run("without completed pods", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return([]model.LoadGenerator{
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return([]model.LoadGenerator{
 			{
 				Name:       "lg-1",
 				ClusterIP:  "127.0.0.1",
@@ -202,21 +265,21 @@ func Test_outdated_regularCleaning(t *testing.T) {
 			},
 		}, nil)
 
-		err = rc.regularCleaning(ctx, 24*time.Hour)
+		_, err = rc.regularCleaning(ctx, 24*time.Hour)
 		assert.NoError(t, err)
 	})
 
 	t.Run("nil pods list", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return(nil, nil)
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, nil)
 
-		err = rc.regularCleaning(ctx, 24*time.Hour)
+		_, err = rc.regularCleaning(ctx, 24*time.Hour)
 		assert.NoError(t, err)
 	})
 
 	t.Run("error", func(t *testing.T) {
-		k8sManager.EXPECT().List(ctx).Return(nil, errors.New("some error"))
+		k8sManager.EXPECT().List(ctx, model.GeneratorFilter{}).Return(nil, errors.New("some error"))
 
-		err = rc.regularCleaning(ctx, 24*time.Hour)
+		_, err = rc.regularCleaning(ctx, 24*time.Hour)
 		assert.NotNil(t, err)
 	})
 }
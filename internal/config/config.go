@@ -9,6 +9,8 @@ import (
 // Manager for user config.
 type Manager interface {
 	UnmarshalKey(key string, val interface{}) error
+	// IsSet - value of key is defined in config.
+	IsSet(key string) bool
 }
 
 type managerImpl struct {
@@ -42,3 +44,34 @@ func configType(cfgPath string) string {
 func (m *managerImpl) UnmarshalKey(key string, val interface{}) error {
 	return m.v.UnmarshalKey(key, val)
 }
+
+// IsSet - value of key is defined in config.
+func (m *managerImpl) IsSet(key string) bool {
+	return m.v.IsSet(key)
+}
+
+// WithOverrides - config where values of keys under prefix override values of the same keys of base config,
+// e.g. clusters.eu.kubernetes.namespace overrides kubernetes.namespace for prefix clusters.eu.
+// Values are overridden as a whole, so nested keys of overridden value are not read from base config.
+func WithOverrides(base Manager, prefix string) Manager {
+	return &overridesManager{base: base, prefix: prefix}
+}
+
+type overridesManager struct {
+	base   Manager
+	prefix string
+}
+
+// UnmarshalKey - read overriding value by key, or value of base config if it is not overridden.
+func (m *overridesManager) UnmarshalKey(key string, val interface{}) error {
+	if m.base.IsSet(m.prefix + "." + key) {
+		return m.base.UnmarshalKey(m.prefix+"."+key, val)
+	}
+
+	return m.base.UnmarshalKey(key, val)
+}
+
+// IsSet - value of key is overridden or defined in base config.
+func (m *overridesManager) IsSet(key string) bool {
+	return m.base.IsSet(m.prefix+"."+key) || m.base.IsSet(key)
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spirt-t/lg-operator/internal/model"
@@ -63,3 +65,49 @@ func TestManager(t *testing.T) {
 		assert.True(t, enabled)
 	})
 }
+
+const overridesConfig = `
+kubernetes:
+  namespace: default
+  generator:
+    label: load-generator
+
+clusters:
+  eu:
+    kubernetes:
+      namespace: perf-eu
+`
+
+func TestWithOverrides(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte(overridesConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	base, err := NewManager(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mngr := WithOverrides(base, "clusters.eu")
+
+	t.Run("overridden", func(t *testing.T) {
+		var namespace string
+		err = mngr.UnmarshalKey("kubernetes.namespace", &namespace)
+		assert.NoError(t, err)
+		assert.Equal(t, "perf-eu", namespace)
+	})
+
+	t.Run("not overridden", func(t *testing.T) {
+		var label string
+		err = mngr.UnmarshalKey("kubernetes.generator.label", &label)
+		assert.NoError(t, err)
+		assert.Equal(t, "load-generator", label)
+	})
+
+	t.Run("is set", func(t *testing.T) {
+		assert.True(t, mngr.IsSet("kubernetes.namespace"))
+		assert.True(t, mngr.IsSet("kubernetes.generator.label"))
+		assert.False(t, mngr.IsSet("kubernetes.context"))
+	})
+}
@@ -0,0 +1,134 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ammoVolumeName = "ammo"
+	// ammoAnnotation - comma-separated ids of ammo mounted into generator.
+	ammoAnnotation = "lg-operator/ammo"
+)
+
+// AmmoVolume - volume sharing ammo storage of the operator with generators; exactly one source is set.
+/*
+  - ClaimName - name of PersistentVolumeClaim mounted by the operator as ammo directory; a claim of the same volume
+    with this name must exist in every namespace and cluster of generators with ammo;
+  - HostPath - directory of nodes sharing content of ammo directory, e.g. mount of network storage.
+*/
+type AmmoVolume struct {
+	ClaimName string `mapstructure:"claim_name"`
+	HostPath  string `mapstructure:"host_path"`
+}
+
+// addAmmo mounts every ammo file of storage volume read-only by its id as sub path.
+func addAmmo(spec *coreV1.PodSpec, volume AmmoVolume, ammo []model.AmmoMount) error {
+	if len(ammo) == 0 {
+		return nil
+	}
+
+	if err := validateAmmo(ammo); err != nil {
+		return err
+	}
+
+	source := coreV1.VolumeSource{}
+	if volume.ClaimName != "" {
+		source.PersistentVolumeClaim = &coreV1.PersistentVolumeClaimVolumeSource{
+			ClaimName: volume.ClaimName,
+			ReadOnly:  true,
+		}
+	} else {
+		source.HostPath = &coreV1.HostPathVolumeSource{Path: volume.HostPath}
+	}
+
+	spec.Volumes = append(spec.Volumes, coreV1.Volume{Name: ammoVolumeName, VolumeSource: source})
+
+	for _, a := range ammo {
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, coreV1.VolumeMount{
+			Name:      ammoVolumeName,
+			MountPath: path.Clean(a.MountPath),
+			SubPath:   a.ID,
+			ReadOnly:  true,
+		})
+	}
+
+	return nil
+}
+
+// validateAmmoClaim - claim of ammo volume must exist in the namespace, since pvc is not shared between namespaces.
+func (m *managerImpl) validateAmmoClaim(ctx context.Context, volume AmmoVolume, ammo []model.AmmoMount) error {
+	if len(ammo) == 0 || volume.ClaimName == "" {
+		return nil
+	}
+
+	_, err := m.client.Get().CoreV1().PersistentVolumeClaims(m.namespace).Get(ctx, volume.ClaimName, metaV1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return fmt.Errorf("%w: claim %q of ammo volume not found in namespace %s", ErrInvalidArgument, volume.ClaimName, m.namespace)
+		}
+
+		return fmt.Errorf("fail to get claim %s of ammo volume: %w", volume.ClaimName, err)
+	}
+
+	return nil
+}
+
+func validateAmmo(ammo []model.AmmoMount) error {
+	var (
+		err   error
+		paths = make(map[string]struct{}, len(ammo))
+	)
+
+	for _, a := range ammo {
+		if !path.IsAbs(a.MountPath) {
+			err = multierr.Append(err, fmt.Errorf("mount path %q of ammo %s is not absolute", a.MountPath, a.ID))
+			continue
+		}
+
+		if _, ok := paths[path.Clean(a.MountPath)]; ok {
+			err = multierr.Append(err, fmt.Errorf("mount path %q of ammo is duplicated", a.MountPath))
+		}
+		paths[path.Clean(a.MountPath)] = struct{}{}
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return nil
+}
+
+// ammoIDs - ids of ammo mounted into generator from its annotations.
+func ammoIDs(annotations map[string]string) []string {
+	if annotations[ammoAnnotation] == "" {
+		return nil
+	}
+
+	return strings.Split(annotations[ammoAnnotation], ",")
+}
+
+// ammoAnnotationValue - ids of mounted ammo without duplicates.
+func ammoAnnotationValue(ammo []model.AmmoMount) string {
+	ids := make([]string, 0, len(ammo))
+	seen := make(map[string]struct{}, len(ammo))
+
+	for _, a := range ammo {
+		if _, ok := seen[a.ID]; ok {
+			continue
+		}
+
+		seen[a.ID] = struct{}{}
+		ids = append(ids, a.ID)
+	}
+
+	return strings.Join(ids, ",")
+}
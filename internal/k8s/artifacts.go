@@ -0,0 +1,177 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	artifactsContainerName = "artifacts"
+	// artifactsRoot - directory of sidecar container where artifact paths of generator are mounted.
+	artifactsRoot = "/artifacts"
+)
+
+var (
+	// ErrNoArtifacts - generator does not keep artifacts or its sidecar is not running.
+	ErrNoArtifacts = errors.New("generator has no artifacts")
+)
+
+// ArtifactsConfig - files of generator container kept by sidecar container after the generator completes.
+/*
+  - Paths - absolute paths of directories in generator container; collection is disabled if empty;
+  - Image - image of sidecar container; it must contain sh and tar;
+  - Resources - compute resources of sidecar container;
+  - DeadlineGrace - time after ttl of generator k8s fails its pod, so artifacts are collected by cleaner before.
+*/
+type ArtifactsConfig struct {
+	Paths         []string
+	Image         string
+	Resources     model.Resources
+	DeadlineGrace time.Duration
+}
+
+// addArtifactsSidecar - mount volume at every artifact path of generator container
+// and add sidecar container keeping the volumes under artifactsRoot.
+func addArtifactsSidecar(spec *coreV1.PodSpec, cfg ArtifactsConfig) error {
+	if len(cfg.Paths) == 0 {
+		return nil
+	}
+
+	resources, err := defineResources(cfg.Resources)
+	if err != nil {
+		return fmt.Errorf("fail to define resources of artifacts sidecar: %w", err)
+	}
+
+	sidecar := coreV1.Container{
+		Name:  artifactsContainerName,
+		Image: cfg.Image,
+		// exits immediately on deletion of pod
+		Command:   []string{"sh", "-c", "trap 'exit 0' TERM; while true; do sleep 1; done"},
+		Resources: resources,
+	}
+
+	for i, p := range cfg.Paths {
+		if !path.IsAbs(p) {
+			return fmt.Errorf("%w: artifact path %q is not absolute", ErrInvalidArgument, p)
+		}
+
+		name := "artifacts-" + strconv.Itoa(i)
+
+		spec.Volumes = append(spec.Volumes, coreV1.Volume{
+			Name:         name,
+			VolumeSource: coreV1.VolumeSource{EmptyDir: &coreV1.EmptyDirVolumeSource{}},
+		})
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, coreV1.VolumeMount{
+			Name:      name,
+			MountPath: path.Clean(p),
+		})
+		sidecar.VolumeMounts = append(sidecar.VolumeMounts, coreV1.VolumeMount{
+			Name:      name,
+			MountPath: path.Join(artifactsRoot, p),
+			ReadOnly:  true,
+		})
+	}
+
+	spec.Containers = append(spec.Containers, sidecar)
+
+	return nil
+}
+
+// generatorStatus - phase of generator pod. Pod with artifacts sidecar keeps running after
+// generator container terminates, so status is defined by termination of generator container.
+func generatorStatus(pod coreV1.Pod) coreV1.PodPhase {
+	if pod.Status.Phase != coreV1.PodRunning || containerStatus(pod, artifactsContainerName) == nil {
+		return pod.Status.Phase
+	}
+
+	status := generatorContainerStatus(pod)
+	if status == nil || status.State.Terminated == nil {
+		return pod.Status.Phase
+	}
+
+	if status.State.Terminated.ExitCode == 0 {
+		return coreV1.PodSucceeded
+	}
+
+	return coreV1.PodFailed
+}
+
+// generatorContainerStatus - status of generator container; statuses are sorted by name, so the first one may be of sidecar.
+func generatorContainerStatus(pod coreV1.Pod) *coreV1.ContainerStatus {
+	if len(pod.Spec.Containers) == 0 {
+		return nil
+	}
+
+	return containerStatus(pod, pod.Spec.Containers[0].Name)
+}
+
+func containerStatus(pod coreV1.Pod, name string) *coreV1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == name {
+			return &pod.Status.ContainerStatuses[i]
+		}
+	}
+
+	return nil
+}
+
+// Archive returns gzipped tar of artifact paths of generator read from its sidecar container.
+// Entries of the archive keep paths of generator container. The caller must close the returned stream;
+// closing it before the end stops archiving.
+func (m *managerImpl) Archive(ctx context.Context, name string) (io.ReadCloser, error) {
+	pod, err := m.getGeneratorPod(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	status := containerStatus(*pod, artifactsContainerName)
+	if status == nil || status.State.Running == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoArtifacts, name)
+	}
+
+	req := m.client.Get().
+		CoreV1().
+		RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(m.namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&coreV1.PodExecOptions{
+			Container: artifactsContainerName,
+			Command:   []string{"tar", "czf", "-", "-C", artifactsRoot, "."},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(m.client.Config(), "POST", req.URL())
+	if err != nil {
+		return nil, fmt.Errorf("fail to exec into pod %s: %w", name, err)
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		var stderr bytes.Buffer
+
+		err := executor.Stream(remotecommand.StreamOptions{Stdout: writer, Stderr: &stderr})
+		if err != nil {
+			err = fmt.Errorf("fail to archive artifacts of generator %s: %w: %s", name, err, stderr.String())
+		}
+
+		_ = writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
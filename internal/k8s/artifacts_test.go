@@ -0,0 +1,115 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// sidecarPod - pod of generator with artifacts sidecar; statuses are sorted by name as kubelet does,
+// so status of sidecar goes first.
+func sidecarPod(phase coreV1.PodPhase, generator coreV1.ContainerState) coreV1.Pod {
+	return coreV1.Pod{
+		Spec: coreV1.PodSpec{Containers: []coreV1.Container{{Name: "lg-1"}, {Name: artifactsContainerName}}},
+		Status: coreV1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []coreV1.ContainerStatus{
+				{Name: artifactsContainerName, State: coreV1.ContainerState{Running: &coreV1.ContainerStateRunning{}}},
+				{Name: "lg-1", State: generator, RestartCount: 2},
+			},
+		},
+	}
+}
+
+func TestMakeDiagnostics_sidecar(t *testing.T) {
+	pod := sidecarPod(coreV1.PodRunning, coreV1.ContainerState{
+		Terminated: &coreV1.ContainerStateTerminated{ExitCode: 3, Reason: "Error"},
+	})
+
+	diagnostics := makeDiagnostics(&pod)
+	assert.Equal(t, model.ContainerStateTerminated, diagnostics.ContainerState)
+	assert.Equal(t, int32(3), diagnostics.ExitCode)
+	assert.Equal(t, "Error", diagnostics.TerminationReason)
+	assert.Equal(t, int32(2), diagnostics.RestartCount)
+}
+
+func TestPodProgress_sidecar(t *testing.T) {
+	pod := sidecarPod(coreV1.PodPending, coreV1.ContainerState{
+		Waiting: &coreV1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+	})
+
+	progress := podProgress(&pod)
+	assert.Equal(t, model.StagePullingImage, progress.Stage)
+	assert.Equal(t, "ImagePullBackOff", progress.Message)
+
+	// generator exited before becoming ready while sidecar keeps the pod running
+	pod = sidecarPod(coreV1.PodRunning, coreV1.ContainerState{
+		Terminated: &coreV1.ContainerStateTerminated{ExitCode: 1},
+	})
+
+	progress = podProgress(&pod)
+	assert.Equal(t, model.StageFailed, progress.Stage)
+	assert.Equal(t, "pod finished before becoming ready: Failed", progress.Message)
+}
+
+func TestActiveDeadlineSeconds(t *testing.T) {
+	seconds := func(s int64) *int64 { return &s }
+
+	assert.Nil(t, activeDeadlineSeconds(0, ArtifactsConfig{}))
+	assert.Equal(t, seconds(90), activeDeadlineSeconds(89500*time.Millisecond, ArtifactsConfig{DeadlineGrace: time.Minute}))
+	// artifacts are collected before k8s fails the pod
+	assert.Equal(t, seconds(150), activeDeadlineSeconds(90*time.Second, ArtifactsConfig{
+		Paths:         []string{"/var/loadtest"},
+		DeadlineGrace: time.Minute,
+	}))
+}
+
+func TestGeneratorStatus(t *testing.T) {
+	running := coreV1.ContainerState{Running: &coreV1.ContainerStateRunning{}}
+	succeeded := coreV1.ContainerState{Terminated: &coreV1.ContainerStateTerminated{ExitCode: 0}}
+	failed := coreV1.ContainerState{Terminated: &coreV1.ContainerStateTerminated{ExitCode: 1}}
+
+	withoutSidecar := func(phase coreV1.PodPhase, generator coreV1.ContainerState) coreV1.Pod {
+		return coreV1.Pod{
+			Spec: coreV1.PodSpec{Containers: []coreV1.Container{{Name: "lg-1"}}},
+			Status: coreV1.PodStatus{
+				Phase:             phase,
+				ContainerStatuses: []coreV1.ContainerStatus{{Name: "lg-1", State: generator}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		pod  coreV1.Pod
+		want coreV1.PodPhase
+	}{
+		{name: "pending with sidecar", pod: sidecarPod(coreV1.PodPending, coreV1.ContainerState{}), want: coreV1.PodPending},
+		{name: "generator running with sidecar", pod: sidecarPod(coreV1.PodRunning, running), want: coreV1.PodRunning},
+		{name: "generator succeeded with sidecar", pod: sidecarPod(coreV1.PodRunning, succeeded), want: coreV1.PodSucceeded},
+		{name: "generator failed with sidecar", pod: sidecarPod(coreV1.PodRunning, failed), want: coreV1.PodFailed},
+		{name: "pod failed with sidecar", pod: sidecarPod(coreV1.PodFailed, failed), want: coreV1.PodFailed},
+		{name: "running without sidecar", pod: withoutSidecar(coreV1.PodRunning, running), want: coreV1.PodRunning},
+		{name: "succeeded without sidecar", pod: withoutSidecar(coreV1.PodSucceeded, succeeded), want: coreV1.PodSucceeded},
+		{
+			name: "generator status is not reported yet",
+			pod: coreV1.Pod{
+				Spec: coreV1.PodSpec{Containers: []coreV1.Container{{Name: "lg-1"}, {Name: artifactsContainerName}}},
+				Status: coreV1.PodStatus{
+					Phase:             coreV1.PodRunning,
+					ContainerStatuses: []coreV1.ContainerStatus{{Name: artifactsContainerName, State: running}},
+				},
+			},
+			want: coreV1.PodRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, generatorStatus(tt.pod))
+		})
+	}
+}
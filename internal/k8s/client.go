@@ -9,17 +9,24 @@ import (
 	"go.uber.org/zap"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 const (
 	k8sHostKey = "kubernetes.service.host"
 	k8sPortKey = "kubernetes.service.port"
+	// kubeconfigKey - path to kubeconfig of cluster; the operator connects to its own cluster if empty.
+	kubeconfigKey = "kubernetes.kubeconfig"
+	// kubeContextKey - context of kubeconfig; the current context of kubeconfig if empty.
+	kubeContextKey = "kubernetes.context"
 )
 
 // Client to k8s.
 type Client interface {
 	Init(ctx context.Context) error
 	Get() *kubernetes.Clientset
+	// Config of connection to k8s; required by exec into containers.
+	Config() *rest.Config
 }
 
 // NewClient constructor for k8s client.
@@ -28,25 +35,65 @@ func NewClient(config config.Manager, logger *zap.Logger) Client {
 }
 
 type clientImpl struct {
-	client *kubernetes.Clientset
-	config config.Manager
-	logger *zap.Logger
+	client     *kubernetes.Clientset
+	restConfig *rest.Config
+	config     config.Manager
+	logger     *zap.Logger
 }
 
-// Init client.
+// Init client with kubeconfig of config, or with service account of the operator's pod if kubeconfig is not set.
 func (c *clientImpl) Init(_ context.Context) error {
+	configKuber, err := c.makeRestConfig()
+	if err != nil {
+		return err
+	}
+
+	c.restConfig = configKuber
+
+	c.client, err = kubernetes.NewForConfig(configKuber)
+	if err != nil {
+		return fmt.Errorf("fail to make k8s client: %w", err)
+	}
+
+	info, err := c.client.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("fail to make k8s client: %w", err)
+	}
+
+	c.logger.Info("k8s server version", zap.Stringer("info", info))
+
+	return nil
+}
+
+func (c *clientImpl) makeRestConfig() (*rest.Config, error) {
 	var (
-		host, port string
+		host, port              string
+		kubeconfig, kubeContext string
 	)
 
+	_ = c.config.UnmarshalKey(kubeconfigKey, &kubeconfig)
+	_ = c.config.UnmarshalKey(kubeContextKey, &kubeContext)
+
+	if kubeconfig != "" {
+		configKuber, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+			&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+		).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("fail to read kubeconfig %s: %w", kubeconfig, err)
+		}
+
+		return configKuber, nil
+	}
+
 	err := c.config.UnmarshalKey(k8sHostKey, &host)
 	if err != nil {
-		return fmt.Errorf("fail to get parameter %s: %w", k8sHostKey, err)
+		return nil, fmt.Errorf("fail to get parameter %s: %w", k8sHostKey, err)
 	}
 
 	err = c.config.UnmarshalKey(k8sPortKey, &port)
 	if err != nil {
-		return fmt.Errorf("fail to get parameter %s: %w", k8sPortKey, err)
+		return nil, fmt.Errorf("fail to get parameter %s: %w", k8sPortKey, err)
 	}
 
 	os.Setenv("KUBERNETES_SERVICE_HOST", host)
@@ -54,25 +101,18 @@ func (c *clientImpl) Init(_ context.Context) error {
 
 	configKuber, err := rest.InClusterConfig()
 	if err != nil {
-		return fmt.Errorf("fail to read k8s cluster config: %w", err)
-	}
-
-	c.client, err = kubernetes.NewForConfig(configKuber)
-	if err != nil {
-		return fmt.Errorf("fail to make k8s client: %w", err)
+		return nil, fmt.Errorf("fail to read k8s cluster config: %w", err)
 	}
 
-	info, err := c.client.Discovery().ServerVersion()
-	if err != nil {
-		return fmt.Errorf("fail to make k8s client: %w", err)
-	}
-
-	c.logger.Info("k8s server version", zap.Stringer("info", info))
-
-	return nil
+	return configKuber, nil
 }
 
 // Get connection to k8s.
 func (c *clientImpl) Get() *kubernetes.Clientset {
 	return c.client
 }
+
+// Config of connection to k8s.
+func (c *clientImpl) Config() *rest.Config {
+	return c.restConfig
+}
@@ -0,0 +1,445 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	clustersKey       = "clusters"
+	defaultClusterKey = "default_cluster"
+)
+
+// Cluster - k8s cluster generators are placed in.
+/*
+  - Name - name of cluster in config; empty if config declares no clusters;
+  - Region - region of cluster; optional;
+  - Config - config of the operator where keys of cluster section override global keys,
+    e.g. clusters.<name>.kubernetes.namespace overrides kubernetes.namespace.
+*/
+type Cluster struct {
+	Name   string
+	Region string
+	Config config.Manager
+}
+
+// Clusters of config; the default cluster is the first one, the others are ordered by name.
+// The only cluster is the one of the operator's pod configured by global keys if config declares no clusters.
+func Clusters(cfg config.Manager) ([]Cluster, error) {
+	var (
+		sections       map[string]struct{ Region string }
+		defaultCluster string
+	)
+
+	if err := cfg.UnmarshalKey(clustersKey, &sections); err != nil {
+		return nil, fmt.Errorf("fail to get parameter %s: %w", clustersKey, err)
+	}
+
+	if len(sections) == 0 {
+		return []Cluster{{Config: cfg}}, nil
+	}
+
+	_ = cfg.UnmarshalKey(defaultClusterKey, &defaultCluster)
+
+	var (
+		names []string
+		err   error
+	)
+
+	for name := range sections {
+		for _, msg := range validation.IsDNS1123Label(name) {
+			err = multierr.Append(err, fmt.Errorf("cluster name %q: %s", name, msg))
+		}
+
+		names = append(names, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	if defaultCluster == "" {
+		defaultCluster = names[0]
+	}
+
+	if _, ok := sections[defaultCluster]; !ok {
+		return nil, fmt.Errorf("default cluster %q is not declared in %s", defaultCluster, clustersKey)
+	}
+
+	clusters := []Cluster{{
+		Name:   defaultCluster,
+		Region: sections[defaultCluster].Region,
+		Config: config.WithOverrides(cfg, clustersKey+"."+defaultCluster),
+	}}
+
+	for _, name := range names {
+		if name == defaultCluster {
+			continue
+		}
+
+		clusters = append(clusters, Cluster{
+			Name:   name,
+			Region: sections[name].Region,
+			Config: config.WithOverrides(cfg, clustersKey+"."+name),
+		})
+	}
+
+	return clusters, nil
+}
+
+// clusterManager - manager of generators of several clusters; the first cluster is the default one.
+// Generators are created in cluster of CreationConfig, generators of all clusters are listed
+// unless filter has cluster, and generators are found by name in all clusters.
+// Generators it returns are marked with name of their cluster.
+type clusterManager struct {
+	clusters []string
+	managers map[string]Manager
+}
+
+// NewClusterManager - manager of generators of clusters with managers of each cluster by its name.
+// Manager of the only cluster is returned as is if config declares no clusters.
+func NewClusterManager(clusters []Cluster, managers map[string]Manager) Manager {
+	if len(clusters) == 1 && clusters[0].Name == "" {
+		return managers[""]
+	}
+
+	m := &clusterManager{managers: managers}
+	for _, cluster := range clusters {
+		m.clusters = append(m.clusters, cluster.Name)
+	}
+
+	return m
+}
+
+// manager of cluster; manager of the default cluster if name is empty.
+func (m *clusterManager) manager(cluster string) (string, Manager, error) {
+	if cluster == "" {
+		cluster = m.clusters[0]
+	}
+
+	manager, ok := m.managers[cluster]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: cluster %q is not managed by the operator", ErrInvalidArgument, cluster)
+	}
+
+	return cluster, manager, nil
+}
+
+// scope - clusters with name; all clusters if name is empty.
+func (m *clusterManager) scope(cluster string) ([]string, error) {
+	if cluster == "" {
+		return m.clusters, nil
+	}
+
+	if _, ok := m.managers[cluster]; !ok {
+		return nil, fmt.Errorf("%w: cluster %q is not managed by the operator", ErrInvalidArgument, cluster)
+	}
+
+	return []string{cluster}, nil
+}
+
+// owner - cluster of generator with name.
+func (m *clusterManager) owner(ctx context.Context, name string) (string, Manager, error) {
+	if len(m.clusters) == 1 {
+		return m.manager("")
+	}
+
+	for _, cluster := range m.clusters {
+		exists, err := m.managers[cluster].Exists(ctx, name)
+		if err != nil {
+			return "", nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		if exists {
+			return cluster, m.managers[cluster], nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+func markCluster(generators []model.LoadGenerator, cluster string) []model.LoadGenerator {
+	for i := range generators {
+		generators[i].Cluster = cluster
+	}
+
+	return generators
+}
+
+// Create load-generator in cluster of cfg.
+func (m *clusterManager) Create(ctx context.Context, cfg CreationConfig) (*model.LoadGenerator, error) {
+	cluster, manager, err := m.manager(cfg.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	generator, err := manager.Create(ctx, cfg)
+	if generator != nil {
+		generator.Cluster = cluster
+	}
+
+	return generator, err
+}
+
+// Render load-generator in cluster of cfg.
+func (m *clusterManager) Render(ctx context.Context, cfg CreationConfig, opts model.RenderOptions) (*model.RenderedGenerator, error) {
+	_, manager, err := m.manager(cfg.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Render(ctx, cfg, opts)
+}
+
+// Delete load generator by name.
+func (m *clusterManager) Delete(ctx context.Context, name string) error {
+	_, manager, err := m.owner(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return manager.Delete(ctx, name)
+}
+
+// DeleteAll generators of namespace in clusters managing it; generators of all namespaces of all clusters
+// if namespace is empty.
+func (m *clusterManager) DeleteAll(ctx context.Context, namespace string) error {
+	var (
+		err     error
+		managed bool
+	)
+
+	for _, cluster := range m.clusters {
+		er := m.managers[cluster].DeleteAll(ctx, namespace)
+		if errors.Is(er, ErrInvalidArgument) {
+			// namespace is not managed in the cluster
+			continue
+		}
+
+		managed = true
+
+		if er != nil {
+			err = multierr.Append(err, fmt.Errorf("cluster %s: %w", cluster, er))
+		}
+	}
+
+	if !managed {
+		return fmt.Errorf("%w: namespace %q is not managed by the operator", ErrInvalidArgument, namespace)
+	}
+
+	return err
+}
+
+// List of existing load generators satisfying filter ordered by cluster.
+func (m *clusterManager) List(ctx context.Context, filter model.GeneratorFilter) ([]model.LoadGenerator, error) {
+	clusters, err := m.scope(filter.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var generators []model.LoadGenerator
+
+	for _, cluster := range clusters {
+		list, err := m.managers[cluster].List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		generators = append(generators, markCluster(list, cluster)...)
+	}
+
+	return generators, nil
+}
+
+// ListPage returns page of load generators satisfying filter ordered by cluster.
+// Page token is prefixed with cluster the listing is continued in.
+func (m *clusterManager) ListPage(ctx context.Context, filter model.GeneratorFilter, page model.PageRequest) (model.GeneratorsPage, error) {
+	clusters, err := m.scope(filter.Cluster)
+	if err != nil {
+		return model.GeneratorsPage{}, err
+	}
+
+	return pageOfParts(clusters, ":", page, func(cluster string, page model.PageRequest) (model.GeneratorsPage, error) {
+		result, err := m.managers[cluster].ListPage(ctx, filter, page)
+		markCluster(result.Generators, cluster)
+
+		return result, err
+	})
+}
+
+// GetRun returns run with its generators of all clusters.
+func (m *clusterManager) GetRun(ctx context.Context, id string) (*model.Run, error) {
+	var generators []model.LoadGenerator
+
+	for _, cluster := range m.clusters {
+		run, err := m.managers[cluster].GetRun(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrRunNotFound) {
+				continue
+			}
+
+			return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		generators = append(generators, markCluster(run.Generators, cluster)...)
+	}
+
+	runs := groupRuns(generators)
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return &runs[0], nil
+}
+
+// ListRuns returns runs of all clusters; the most recent first.
+func (m *clusterManager) ListRuns(ctx context.Context) ([]model.Run, error) {
+	var generators []model.LoadGenerator
+
+	for _, cluster := range m.clusters {
+		runs, err := m.managers[cluster].ListRuns(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		for _, run := range runs {
+			generators = append(generators, markCluster(run.Generators, cluster)...)
+		}
+	}
+
+	return groupRuns(generators), nil
+}
+
+// DeleteRun deletes generators of run in all clusters.
+func (m *clusterManager) DeleteRun(ctx context.Context, id string) error {
+	var (
+		err   error
+		found bool
+	)
+
+	for _, cluster := range m.clusters {
+		er := m.managers[cluster].DeleteRun(ctx, id)
+		if errors.Is(er, ErrRunNotFound) {
+			continue
+		}
+
+		found = true
+
+		if er != nil {
+			err = multierr.Append(err, fmt.Errorf("cluster %s: %w", cluster, er))
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return err
+}
+
+// Exists - generator with name exists in any cluster.
+func (m *clusterManager) Exists(ctx context.Context, name string) (bool, error) {
+	for _, cluster := range m.clusters {
+		exists, err := m.managers[cluster].Exists(ctx, name)
+		if err != nil {
+			return false, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		if exists {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Get generator by name.
+func (m *clusterManager) Get(ctx context.Context, name string) (*model.GeneratorDetails, error) {
+	for _, cluster := range m.clusters {
+		details, err := m.managers[cluster].Get(ctx, name)
+		if err == nil {
+			details.Generator.Cluster = cluster
+			return details, nil
+		}
+
+		if !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Watch changes of load generators of all clusters. Clusters are watched concurrently, while handle
+// is called sequentially. Resource version of event joins the last versions of all clusters, e.g. "eu=1042,us=977".
+func (m *clusterManager) Watch(ctx context.Context, resourceVersion string, handle func(model.GeneratorEvent) error) error {
+	return watchParts(ctx, m.clusters, ",", "=", resourceVersion, handle,
+		func(ctx context.Context, cluster, from string, handle func(model.GeneratorEvent) error) error {
+			return m.managers[cluster].Watch(ctx, from, func(event model.GeneratorEvent) error {
+				event.Generator.Cluster = cluster
+				return handle(event)
+			})
+		})
+}
+
+// Logs of generator by name.
+func (m *clusterManager) Logs(ctx context.Context, name string, opts model.LogOptions) (io.ReadCloser, error) {
+	_, manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Logs(ctx, name, opts)
+}
+
+// ListByIdempotencyKey returns generators created with idempotency key in all clusters.
+func (m *clusterManager) ListByIdempotencyKey(ctx context.Context, key string) ([]model.LoadGenerator, error) {
+	var generators []model.LoadGenerator
+
+	for _, cluster := range m.clusters {
+		list, err := m.managers[cluster].ListByIdempotencyKey(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+		}
+
+		generators = append(generators, markCluster(list, cluster)...)
+	}
+
+	return generators, nil
+}
+
+// RenewLease of generator by name.
+func (m *clusterManager) RenewLease(ctx context.Context, name string, duration time.Duration) (*model.LoadGenerator, error) {
+	cluster, manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	generator, err := manager.RenewLease(ctx, name, duration)
+	if generator != nil {
+		generator.Cluster = cluster
+	}
+
+	return generator, err
+}
+
+// Archive artifacts of generator by name.
+func (m *clusterManager) Archive(ctx context.Context, name string) (io.ReadCloser, error) {
+	_, manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Archive(ctx, name)
+}
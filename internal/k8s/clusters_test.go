@@ -0,0 +1,78 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spirt-t/lg-operator/internal/k8s"
+	mock_k8s "github.com/spirt-t/lg-operator/internal/k8s/mock"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterManager(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eu, us := mock_k8s.NewMockManager(ctrl), mock_k8s.NewMockManager(ctrl)
+	m := k8s.NewClusterManager(
+		[]k8s.Cluster{{Name: "eu"}, {Name: "us"}},
+		map[string]k8s.Manager{"eu": eu, "us": us},
+	)
+
+	t.Run("owner is looked up without details", func(t *testing.T) {
+		eu.EXPECT().Exists(ctx, "generator-1").Return(false, nil)
+		us.EXPECT().Exists(ctx, "generator-1").Return(true, nil)
+		us.EXPECT().Logs(ctx, "generator-1", model.LogOptions{}).Return(io.NopCloser(strings.NewReader("logs")), nil)
+
+		logs, err := m.Logs(ctx, "generator-1", model.LogOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, logs)
+	})
+
+	t.Run("delete in owner cluster", func(t *testing.T) {
+		// generator whose pod is gone is still found by its service
+		eu.EXPECT().Exists(ctx, "generator-3").Return(false, nil)
+		us.EXPECT().Exists(ctx, "generator-3").Return(true, nil)
+		us.EXPECT().Delete(ctx, "generator-3").Return(nil)
+
+		assert.NoError(t, m.Delete(ctx, "generator-3"))
+	})
+
+	t.Run("unknown generator", func(t *testing.T) {
+		eu.EXPECT().Exists(ctx, "unknown").Return(false, nil)
+		us.EXPECT().Exists(ctx, "unknown").Return(false, nil)
+
+		_, err := m.Logs(ctx, "unknown", model.LogOptions{})
+		assert.ErrorIs(t, err, k8s.ErrNotFound)
+	})
+
+	t.Run("lookup error", func(t *testing.T) {
+		er := errors.New("some error")
+		eu.EXPECT().Exists(ctx, "generator-2").Return(false, er)
+
+		_, err := m.Logs(ctx, "generator-2", model.LogOptions{})
+		assert.ErrorIs(t, err, er)
+		assert.Contains(t, err.Error(), "cluster eu")
+	})
+
+	t.Run("delete run", func(t *testing.T) {
+		eu.EXPECT().DeleteRun(ctx, "run-1").Return(fmt.Errorf("%w: run-1", k8s.ErrRunNotFound))
+		us.EXPECT().DeleteRun(ctx, "run-1").Return(nil)
+
+		assert.NoError(t, m.DeleteRun(ctx, "run-1"))
+	})
+
+	t.Run("delete unknown run", func(t *testing.T) {
+		eu.EXPECT().DeleteRun(ctx, "unknown").Return(fmt.Errorf("%w: unknown", k8s.ErrRunNotFound))
+		us.EXPECT().DeleteRun(ctx, "unknown").Return(fmt.Errorf("%w: unknown", k8s.ErrRunNotFound))
+
+		assert.ErrorIs(t, m.DeleteRun(ctx, "unknown"), k8s.ErrRunNotFound)
+	})
+}
@@ -0,0 +1,135 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/zap"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const (
+	maxGeneratorEvents = 20
+)
+
+var (
+	// kinds of k8s entities deployed for every load-generator.
+	generatorKinds = map[string]struct{}{
+		"Pod":     {},
+		"Service": {},
+		"Ingress": {},
+	}
+)
+
+// Get load generator by name with diagnostics of its pod.
+func (m *managerImpl) Get(ctx context.Context, name string) (*model.GeneratorDetails, error) {
+	pod, err := m.getGeneratorPod(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := m.getGeneratorService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := makeDiagnostics(pod)
+
+	diagnostics.Events, err = m.listEvents(ctx, name)
+	if err != nil {
+		m.logger.Warn("fail to get generator events", zap.String("generator_name", name), zap.Error(err))
+	}
+
+	return &model.GeneratorDetails{
+		Generator:   makeLoadGenerator(*pod, *service),
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+func makeDiagnostics(pod *coreV1.Pod) model.GeneratorDiagnostics {
+	diagnostics := model.GeneratorDiagnostics{
+		NodeName: pod.Spec.NodeName,
+		PodIP:    pod.Status.PodIP,
+	}
+
+	status := generatorContainerStatus(*pod)
+	if status == nil {
+		return diagnostics
+	}
+
+	diagnostics.RestartCount = status.RestartCount
+
+	switch {
+	case status.State.Waiting != nil:
+		diagnostics.ContainerState = model.ContainerStateWaiting
+		diagnostics.WaitingReason = status.State.Waiting.Reason
+	case status.State.Running != nil:
+		diagnostics.ContainerState = model.ContainerStateRunning
+		diagnostics.StartedAt = status.State.Running.StartedAt.Time
+	case status.State.Terminated != nil:
+		diagnostics.ContainerState = model.ContainerStateTerminated
+		diagnostics.StartedAt = status.State.Terminated.StartedAt.Time
+		diagnostics.FinishedAt = status.State.Terminated.FinishedAt.Time
+		diagnostics.ExitCode = status.State.Terminated.ExitCode
+		diagnostics.TerminationReason = status.State.Terminated.Reason
+		diagnostics.TerminationMessage = status.State.Terminated.Message
+	}
+
+	return diagnostics
+}
+
+// listEvents returns the most recent events of generator pod, service and ingress sorted by time.
+func (m *managerImpl) listEvents(ctx context.Context, name string) ([]model.Event, error) {
+	eventsList, err := m.client.Get().
+		CoreV1().
+		Events(m.namespace).
+		List(ctx, metaV1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("involvedObject.name", name).String(),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("fail to get list of events: %w", err)
+	}
+
+	events := make([]model.Event, 0, len(eventsList.Items))
+
+	for _, event := range eventsList.Items {
+		if _, ok := generatorKinds[event.InvolvedObject.Kind]; !ok {
+			continue
+		}
+
+		events = append(events, model.Event{
+			ObjectKind:    event.InvolvedObject.Kind,
+			Type:          event.Type,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: eventTime(event),
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(events[j].LastTimestamp)
+	})
+
+	if len(events) > maxGeneratorEvents {
+		events = events[len(events)-maxGeneratorEvents:]
+	}
+
+	return events, nil
+}
+
+func eventTime(event coreV1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.FirstTimestamp.Time
+	}
+}
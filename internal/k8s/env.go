@@ -0,0 +1,266 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	secretKind    = "secret"
+	configMapKind = "config map"
+
+	// envSourceLabelKey - label of secrets and config maps generators may take environment variables from.
+	envSourceLabelKey     = "kubernetes.generator.env_source_label"
+	defaultEnvSourceLabel = "lg-operator/env-source"
+)
+
+// buildEnv maps environment variables of generator to container variables.
+func buildEnv(envs []model.EnvVar) []coreV1.EnvVar {
+	envVars := make([]coreV1.EnvVar, 0, len(envs))
+
+	for _, env := range envs {
+		envVar := coreV1.EnvVar{Name: env.Name}
+
+		switch {
+		case env.SecretKeyRef != nil:
+			envVar.ValueFrom = &coreV1.EnvVarSource{SecretKeyRef: &coreV1.SecretKeySelector{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: env.SecretKeyRef.Name},
+				Key:                  env.SecretKeyRef.Key,
+				Optional:             optional(env.SecretKeyRef.Optional),
+			}}
+		case env.ConfigMapKeyRef != nil:
+			envVar.ValueFrom = &coreV1.EnvVarSource{ConfigMapKeyRef: &coreV1.ConfigMapKeySelector{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: env.ConfigMapKeyRef.Name},
+				Key:                  env.ConfigMapKeyRef.Key,
+				Optional:             optional(env.ConfigMapKeyRef.Optional),
+			}}
+		default:
+			envVar.Value = env.Value
+		}
+
+		envVars = append(envVars, envVar)
+	}
+
+	return envVars
+}
+
+// buildEnvFrom maps imports of secrets and config maps to container sources.
+func buildEnvFrom(envFrom []model.EnvFrom) []coreV1.EnvFromSource {
+	if len(envFrom) == 0 {
+		return nil
+	}
+
+	sources := make([]coreV1.EnvFromSource, 0, len(envFrom))
+
+	for _, from := range envFrom {
+		source := coreV1.EnvFromSource{Prefix: from.Prefix}
+
+		if from.SecretName != "" {
+			source.SecretRef = &coreV1.SecretEnvSource{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: from.SecretName},
+				Optional:             optional(from.Optional),
+			}
+		} else {
+			source.ConfigMapRef = &coreV1.ConfigMapEnvSource{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: from.ConfigMapName},
+				Optional:             optional(from.Optional),
+			}
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources
+}
+
+func optional(val bool) *bool {
+	if !val {
+		return nil
+	}
+
+	return &val
+}
+
+// validateEnvSources checks that secrets and config maps referenced by environment variables exist
+// and contain referenced keys, so generator does not hang with CreateContainerConfigError. Optional references
+// to missing objects are skipped. Referenced objects must carry the env source label, so generators can not read
+// other secrets of the namespace, e.g. credentials of the operator.
+func (m *managerImpl) validateEnvSources(ctx context.Context, cfg CreationConfig) error {
+	label := defaultEnvSourceLabel
+	if er := m.config.UnmarshalKey(envSourceLabelKey, &label); er != nil || label == "" {
+		label = defaultEnvSourceLabel
+	}
+
+	return checkEnvSources(cfg, label, func(kind, name string) (*envSource, error) {
+		return m.envSource(ctx, kind, name)
+	})
+}
+
+// checkEnvSources validates environment variables of generator against objects returned by get.
+func checkEnvSources(cfg CreationConfig, label string, get func(kind, name string) (*envSource, error)) error {
+	var (
+		err, getErr error
+		objects     = make(map[string]*envSource)
+	)
+
+	// source returns secret or config map; nil if it does not exist or fails to be read
+	source := func(kind, name string) *envSource {
+		id := kind + "/" + name
+		if obj, ok := objects[id]; ok {
+			return obj
+		}
+
+		obj, er := get(kind, name)
+		if er != nil {
+			getErr = multierr.Append(getErr, er)
+			return nil
+		}
+
+		objects[id] = obj
+
+		return obj
+	}
+
+	// checkSource checks that the object exists unless it is optional and may be referenced by generators
+	checkSource := func(kind, name string, optional bool, ref string) (*envSource, error) {
+		obj := source(kind, name)
+		if obj == nil {
+			if optional {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("%s %q of %s not found", kind, name, ref)
+		}
+
+		if _, ok := obj.labels[label]; !ok {
+			return nil, fmt.Errorf("%s %q of %s has no label %s allowing generators to use it", kind, name, ref, label)
+		}
+
+		return obj, nil
+	}
+
+	checkKey := func(kind string, ref *model.KeyRef, envName string) error {
+		if ref.Name == "" || ref.Key == "" {
+			return fmt.Errorf("%s name and key of variable %q are required", kind, envName)
+		}
+
+		obj, er := checkSource(kind, ref.Name, ref.Optional, fmt.Sprintf("variable %q", envName))
+		if er != nil || obj == nil || ref.Optional {
+			return er
+		}
+
+		if _, ok := obj.keys[ref.Key]; !ok {
+			return fmt.Errorf("key %q of %s %q of variable %q not found", ref.Key, kind, ref.Name, envName)
+		}
+
+		return nil
+	}
+
+	for _, env := range cfg.Envs {
+		switch {
+		case env.SecretKeyRef != nil && env.ConfigMapKeyRef != nil:
+			err = multierr.Append(err, fmt.Errorf("variable %q refers to both secret and config map", env.Name))
+		case env.SecretKeyRef != nil:
+			err = multierr.Append(err, checkKey(secretKind, env.SecretKeyRef, env.Name))
+		case env.ConfigMapKeyRef != nil:
+			err = multierr.Append(err, checkKey(configMapKind, env.ConfigMapKeyRef, env.Name))
+		}
+	}
+
+	for _, from := range cfg.EnvFrom {
+		if (from.SecretName == "") == (from.ConfigMapName == "") {
+			err = multierr.Append(err, fmt.Errorf("exactly one of secret and config map of env_from is required"))
+			continue
+		}
+
+		kind, name := secretKind, from.SecretName
+		if from.ConfigMapName != "" {
+			kind, name = configMapKind, from.ConfigMapName
+		}
+
+		_, er := checkSource(kind, name, from.Optional, "env_from")
+		err = multierr.Append(err, er)
+	}
+
+	if getErr != nil {
+		// missing objects may be reported due to failed reading
+		return getErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return nil
+}
+
+// envSource - keys and labels of secret or config map.
+type envSource struct {
+	keys   map[string]struct{}
+	labels map[string]string
+}
+
+// objectKeys - keys of secret or config map of the namespace; nil if the object does not exist.
+func (m *managerImpl) objectKeys(ctx context.Context, kind, name string) (map[string]struct{}, error) {
+	obj, err := m.envSource(ctx, kind, name)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+
+	return obj.keys, nil
+}
+
+// envSource - secret or config map of the namespace; nil if the object does not exist.
+func (m *managerImpl) envSource(ctx context.Context, kind, name string) (*envSource, error) {
+	obj := &envSource{keys: make(map[string]struct{})}
+
+	if kind == secretKind {
+		secret, err := m.client.Get().CoreV1().Secrets(m.namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			if apiErrors.IsNotFound(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("fail to get secret %s: %w", name, err)
+		}
+
+		for key := range secret.Data {
+			obj.keys[key] = struct{}{}
+		}
+
+		for key := range secret.StringData {
+			obj.keys[key] = struct{}{}
+		}
+
+		obj.labels = secret.Labels
+
+		return obj, nil
+	}
+
+	configMap, err := m.client.Get().CoreV1().ConfigMaps(m.namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("fail to get config map %s: %w", name, err)
+	}
+
+	for key := range configMap.Data {
+		obj.keys[key] = struct{}{}
+	}
+
+	for key := range configMap.BinaryData {
+		obj.keys[key] = struct{}{}
+	}
+
+	obj.labels = configMap.Labels
+
+	return obj, nil
+}
@@ -0,0 +1,92 @@
+package k8s
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckEnvSources(t *testing.T) {
+	const label = "lg-operator/env-source"
+
+	objects := map[string]*envSource{
+		secretKind + "/allowed": {
+			keys:   map[string]struct{}{"token": {}},
+			labels: map[string]string{label: ""},
+		},
+		secretKind + "/operator": {
+			keys: map[string]struct{}{"token": {}},
+		},
+		configMapKind + "/allowed": {
+			keys:   map[string]struct{}{"url": {}},
+			labels: map[string]string{label: "true"},
+		},
+		configMapKind + "/other": {
+			keys:   map[string]struct{}{"url": {}},
+			labels: map[string]string{"app": "other"},
+		},
+	}
+
+	get := func(kind, name string) (*envSource, error) {
+		if name == "broken" {
+			return nil, errors.New("fail")
+		}
+
+		return objects[kind+"/"+name], nil
+	}
+
+	secretRef := func(name, key string, optional bool) []model.EnvVar {
+		return []model.EnvVar{{Name: "VAR", SecretKeyRef: &model.KeyRef{Name: name, Key: key, Optional: optional}}}
+	}
+
+	tests := []struct {
+		name    string
+		cfg     CreationConfig
+		invalid bool
+		wantErr bool
+	}{
+		{name: "no references", cfg: CreationConfig{Envs: []model.EnvVar{{Name: "VAR", Value: "val"}}}},
+		{name: "labeled secret", cfg: CreationConfig{Envs: secretRef("allowed", "token", false)}},
+		{
+			name: "labeled config map",
+			cfg: CreationConfig{Envs: []model.EnvVar{
+				{Name: "VAR", ConfigMapKeyRef: &model.KeyRef{Name: "allowed", Key: "url"}},
+			}},
+		},
+		{name: "secret without label", cfg: CreationConfig{Envs: secretRef("operator", "token", false)}, invalid: true},
+		{name: "optional secret without label", cfg: CreationConfig{Envs: secretRef("operator", "token", true)}, invalid: true},
+		{name: "optional missing secret", cfg: CreationConfig{Envs: secretRef("missing", "token", true)}},
+		{name: "missing secret", cfg: CreationConfig{Envs: secretRef("missing", "token", false)}, invalid: true},
+		{name: "missing key", cfg: CreationConfig{Envs: secretRef("allowed", "password", false)}, invalid: true},
+		{name: "optional missing key", cfg: CreationConfig{Envs: secretRef("allowed", "password", true)}},
+		{name: "env from labeled secret", cfg: CreationConfig{EnvFrom: []model.EnvFrom{{SecretName: "allowed"}}}},
+		{
+			name:    "env from config map with other label",
+			cfg:     CreationConfig{EnvFrom: []model.EnvFrom{{ConfigMapName: "other"}}},
+			invalid: true,
+		},
+		{
+			name:    "optional env from secret without label",
+			cfg:     CreationConfig{EnvFrom: []model.EnvFrom{{SecretName: "operator", Optional: true}}},
+			invalid: true,
+		},
+		{name: "fail to get", cfg: CreationConfig{Envs: secretRef("broken", "token", false)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEnvSources(tt.cfg, label, get)
+			switch {
+			case tt.invalid:
+				assert.ErrorIs(t, err, ErrInvalidArgument)
+			case tt.wantErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, ErrInvalidArgument)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	filesVolumeName = "files"
+	// maxFilesSize - total size of files of generator; k8s limits size of ConfigMap by 1MiB including metadata.
+	maxFilesSize = 1000 * 1024
+)
+
+// buildConfigMap builds ConfigMap keeping files of generator; nil if generator has no files.
+func buildConfigMap(objMeta metaV1.ObjectMeta, files []model.File) (*coreV1.ConfigMap, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if err := validateFiles(files); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(files))
+	for _, file := range files {
+		data[file.Name] = file.Content
+	}
+
+	return &coreV1.ConfigMap{
+		TypeMeta:   metaV1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: objMeta,
+		Data:       data,
+	}, nil
+}
+
+// validateFiles - names of files are keys of ConfigMap, so they must be unique.
+func validateFiles(files []model.File) error {
+	var (
+		err   error
+		size  int
+		names = make(map[string]struct{}, len(files))
+	)
+
+	for _, file := range files {
+		for _, msg := range validation.IsConfigMapKey(file.Name) {
+			err = multierr.Append(err, fmt.Errorf("file name %q: %s", file.Name, msg))
+		}
+
+		if _, ok := names[file.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("file name %q is duplicated", file.Name))
+		}
+		names[file.Name] = struct{}{}
+
+		if !path.IsAbs(file.MountPath) {
+			err = multierr.Append(err, fmt.Errorf("mount path %q of file %q is not absolute", file.MountPath, file.Name))
+		}
+
+		size += len(file.Name) + len(file.Content)
+	}
+
+	if size > maxFilesSize {
+		err = multierr.Append(err, fmt.Errorf("total size of files %d exceeds %d bytes", size, maxFilesSize))
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return nil
+}
+
+// addFiles mounts every file of ConfigMap by its own sub path, so other files of mount directory are kept.
+func addFiles(spec *coreV1.PodSpec, configMapName string, files []model.File) {
+	if len(files) == 0 {
+		return
+	}
+
+	spec.Volumes = append(spec.Volumes, coreV1.Volume{
+		Name: filesVolumeName,
+		VolumeSource: coreV1.VolumeSource{
+			ConfigMap: &coreV1.ConfigMapVolumeSource{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: configMapName},
+			},
+		},
+	})
+
+	for _, file := range files {
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, coreV1.VolumeMount{
+			Name:      filesVolumeName,
+			MountPath: path.Join(file.MountPath, file.Name),
+			SubPath:   file.Name,
+			ReadOnly:  true,
+		})
+	}
+}
+
+func (m *managerImpl) createConfigMap(ctx context.Context, configMap *coreV1.ConfigMap) error {
+	_, err := m.client.Get().
+		CoreV1().
+		ConfigMaps(m.namespace).
+		Create(ctx, configMap, metaV1.CreateOptions{})
+
+	return err
+}
+
+// deleteConfigMap deletes ConfigMap of generator; generator without files has no ConfigMap.
+func (m *managerImpl) deleteConfigMap(ctx context.Context, name string) error {
+	err := m.client.Get().
+		CoreV1().
+		ConfigMaps(m.namespace).
+		Delete(ctx, name, metaV1.DeleteOptions{})
+	if apiErrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []model.File
+		wantErr string
+	}{
+		{
+			name: "valid",
+			files: []model.File{
+				{Name: "load.yaml", MountPath: "/etc/tank", Content: "phantom: {}"},
+				{Name: "ammo.txt", MountPath: "/etc/tank"},
+			},
+		},
+		{
+			name: "duplicated name",
+			files: []model.File{
+				{Name: "load.yaml", MountPath: "/etc/tank"},
+				{Name: "load.yaml", MountPath: "/var/tank"},
+			},
+			wantErr: `file name "load.yaml" is duplicated`,
+		},
+		{
+			name:    "invalid name",
+			files:   []model.File{{Name: "conf/load.yaml", MountPath: "/etc/tank"}},
+			wantErr: `file name "conf/load.yaml"`,
+		},
+		{
+			name:    "relative mount path",
+			files:   []model.File{{Name: "load.yaml", MountPath: "etc/tank"}},
+			wantErr: `mount path "etc/tank" of file "load.yaml" is not absolute`,
+		},
+		{
+			name: "too large",
+			files: []model.File{
+				{Name: "a.txt", MountPath: "/etc", Content: strings.Repeat("a", maxFilesSize/2)},
+				{Name: "b.txt", MountPath: "/etc", Content: strings.Repeat("b", maxFilesSize/2)},
+			},
+			wantErr: "total size of files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFiles(tt.files)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestBuildConfigMap(t *testing.T) {
+	configMap, err := buildConfigMap(metaV1.ObjectMeta{Name: "lg-1"}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, configMap)
+
+	configMap, err = buildConfigMap(metaV1.ObjectMeta{Name: "lg-1"}, []model.File{
+		{Name: "load.yaml", MountPath: "/etc/tank", Content: "phantom: {}"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "lg-1", configMap.Name)
+	assert.Equal(t, map[string]string{"load.yaml": "phantom: {}"}, configMap.Data)
+}
+
+func TestAddFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []model.File
+		want  []coreV1.VolumeMount
+	}{
+		{name: "no files"},
+		{
+			name: "files of the same directory",
+			files: []model.File{
+				{Name: "load.yaml", MountPath: "/etc/tank"},
+				{Name: "ammo.txt", MountPath: "/etc/tank/"},
+			},
+			want: []coreV1.VolumeMount{
+				{Name: filesVolumeName, MountPath: "/etc/tank/load.yaml", SubPath: "load.yaml", ReadOnly: true},
+				{Name: filesVolumeName, MountPath: "/etc/tank/ammo.txt", SubPath: "ammo.txt", ReadOnly: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := coreV1.PodSpec{Containers: []coreV1.Container{{Name: "lg-1"}}}
+
+			addFiles(&spec, "lg-1", tt.files)
+
+			assert.Equal(t, tt.want, spec.Containers[0].VolumeMounts)
+			if len(tt.files) == 0 {
+				assert.Empty(t, spec.Volumes)
+				return
+			}
+
+			assert.Len(t, spec.Volumes, 1)
+			assert.Equal(t, "lg-1", spec.Volumes[0].ConfigMap.Name)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package k8s
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+)
+
+const (
+	idempotencyKeyLabel      = "lg-operator/idempotency-key"
+	idempotencyKeyAnnotation = "lg-operator/idempotency-key"
+	// requestHashAnnotation - hash of creation request of idempotent generator.
+	requestHashAnnotation = "lg-operator/request-hash"
+	idempotencyHashLength = 16
+)
+
+// ListByIdempotencyKey returns generators created with the idempotency key in order of creation parameters.
+func (m *managerImpl) ListByIdempotencyKey(ctx context.Context, key string) ([]model.LoadGenerator, error) {
+	var label string
+	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+		return nil, fmt.Errorf("fail to define label: %w", err)
+	}
+
+	generators, _, err := m.listGenerators(ctx, label+","+idempotencyKeyLabel+"="+idempotencyHash(key))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(generators, func(i, j int) bool {
+		return CreationIndex(generators[i].Name) < CreationIndex(generators[j].Name)
+	})
+
+	return generators, nil
+}
+
+// idempotencyHash - label-safe representation of client-supplied idempotency key.
+func idempotencyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])[:idempotencyHashLength]
+}
+
+// idempotentName - name of generator is derived from idempotency key and index of creation parameters,
+// so k8s rejects concurrent creation of the same generator.
+func idempotentName(label, key string, index int) string {
+	return fmt.Sprintf("%s-%s-%d", label, idempotencyHash(key), index)
+}
+
+// CreationIndex - index of creation parameters encoded in the name of idempotent generator; -1 for other names.
+func CreationIndex(name string) int {
+	index, err := strconv.Atoi(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// imagePullPolicy - pull policy of generator container; images are pulled on every start if it is not set.
+func imagePullPolicy(policy string) (coreV1.PullPolicy, error) {
+	switch pullPolicy := coreV1.PullPolicy(policy); pullPolicy {
+	case "":
+		return coreV1.PullAlways, nil
+	case coreV1.PullAlways, coreV1.PullIfNotPresent, coreV1.PullNever:
+		return pullPolicy, nil
+	default:
+		return "", fmt.Errorf("%w: image pull policy %q is not supported", ErrInvalidArgument, policy)
+	}
+}
+
+func buildPullSecrets(secrets []string) []coreV1.LocalObjectReference {
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	refs := make([]coreV1.LocalObjectReference, 0, len(secrets))
+	for _, secret := range secrets {
+		refs = append(refs, coreV1.LocalObjectReference{Name: secret})
+	}
+
+	return refs
+}
+
+// validatePullSecrets checks that image pull secrets exist, so generator does not hang with ErrImagePull.
+func (m *managerImpl) validatePullSecrets(ctx context.Context, secrets []string) error {
+	var err error
+
+	for _, secret := range secrets {
+		if secret == "" {
+			err = multierr.Append(err, fmt.Errorf("%w: name of image pull secret is empty", ErrInvalidArgument))
+			continue
+		}
+
+		keys, er := m.objectKeys(ctx, secretKind, secret)
+		if er != nil {
+			return er
+		}
+
+		if keys == nil {
+			err = multierr.Append(err, fmt.Errorf("%w: image pull secret %q not found", ErrInvalidArgument, secret))
+		}
+	}
+
+	return err
+}
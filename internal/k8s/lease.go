@@ -0,0 +1,110 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	leaseDurationAnnotation  = "lg-operator/lease-duration"
+	leaseExpiresAtAnnotation = "lg-operator/lease-expires-at"
+)
+
+// RenewLease - extend lease of generator by duration from now; lease duration set on creation is used if it is zero.
+func (m *managerImpl) RenewLease(ctx context.Context, name string, duration time.Duration) (*model.LoadGenerator, error) {
+	pod, err := m.getGeneratorPod(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration <= 0 {
+		duration = leaseDuration(pod.Annotations)
+	}
+
+	if duration <= 0 {
+		return nil, fmt.Errorf("%w: generator %s has no lease", ErrInvalidArgument, name)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": leaseAnnotations(time.Now(), duration),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to make lease patch: %w", err)
+	}
+
+	pod, err = m.client.Get().
+		CoreV1().
+		Pods(m.namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metaV1.PatchOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+
+		return nil, fmt.Errorf("fail to renew lease of generator %s: %w", name, err)
+	}
+
+	service, err := m.getGeneratorService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	generator := makeLoadGenerator(*pod, *service)
+
+	return &generator, nil
+}
+
+// leaseAnnotations - annotations of generator lease of duration started at now.
+func leaseAnnotations(now time.Time, duration time.Duration) map[string]string {
+	return map[string]string{
+		leaseDurationAnnotation:  duration.String(),
+		leaseExpiresAtAnnotation: now.Add(duration).UTC().Format(time.RFC3339),
+	}
+}
+
+// leaseDuration - duration of generator lease; zero if generator has no lease.
+func leaseDuration(annotations map[string]string) time.Duration {
+	duration, err := time.ParseDuration(annotations[leaseDurationAnnotation])
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// leaseExpiresAt - expiration of generator lease; zero time if generator has no lease.
+func leaseExpiresAt(annotations map[string]string) time.Time {
+	expiresAt, err := time.Parse(time.RFC3339, annotations[leaseExpiresAtAnnotation])
+	if err != nil {
+		return time.Time{}
+	}
+
+	return expiresAt
+}
+
+// getGeneratorService returns service of load generator; empty service is returned if it does not exist.
+func (m *managerImpl) getGeneratorService(ctx context.Context, name string) (*coreV1.Service, error) {
+	service, err := m.client.Get().
+		CoreV1().
+		Services(m.namespace).
+		Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("fail to get service %s: %w", name, err)
+		}
+
+		return &coreV1.Service{}, nil
+	}
+
+	return service, nil
+}
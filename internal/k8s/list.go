@@ -0,0 +1,233 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+var (
+	podPhases = []coreV1.PodPhase{
+		coreV1.PodPending,
+		coreV1.PodRunning,
+		coreV1.PodSucceeded,
+		coreV1.PodFailed,
+		coreV1.PodUnknown,
+	}
+)
+
+// ListPage returns page of load generators satisfying filter ordered by name. Statuses and images of generators
+// are checked after listing, so pods are requested from k8s until the page is full or the listing ends;
+// the page has fewer generators than requested only if it is the last one.
+// Services are requested only for pods of the page.
+func (m *managerImpl) ListPage(ctx context.Context, filter model.GeneratorFilter, page model.PageRequest) (model.GeneratorsPage, error) {
+	selector, err := m.labelSelector(filter)
+	if err != nil {
+		return model.GeneratorsPage{}, err
+	}
+
+	fetch := func(limit int64, token string) (*coreV1.PodList, error) {
+		podsList, err := m.client.Get().
+			CoreV1().
+			Pods(m.namespace).
+			List(ctx, metaV1.ListOptions{
+				LabelSelector: selector,
+				FieldSelector: phaseSelector(filter.Statuses),
+				Limit:         limit,
+				Continue:      token,
+			})
+		if err != nil {
+			switch {
+			case apiErrors.IsResourceExpired(err), apiErrors.IsGone(err):
+				return nil, fmt.Errorf("%w: %v", ErrPageTokenExpired, err)
+			case apiErrors.IsBadRequest(err) && token != "":
+				return nil, fmt.Errorf("%w: page token: %v", ErrInvalidArgument, err)
+			}
+
+			return nil, fmt.Errorf("fail to get list of pods: %w", err)
+		}
+
+		return podsList, nil
+	}
+
+	match := func(pod coreV1.Pod) bool {
+		// filter does not check IPs of generator, so its service is not required
+		return filter.Match(makeLoadGenerator(pod, coreV1.Service{}))
+	}
+
+	pods, token, err := fillPage(page, match, fetch)
+	if err != nil {
+		return model.GeneratorsPage{}, err
+	}
+
+	generators, err := m.joinServices(ctx, pods)
+	if err != nil {
+		return model.GeneratorsPage{}, err
+	}
+
+	return model.GeneratorsPage{
+		Generators:    generators,
+		NextPageToken: token,
+	}, nil
+}
+
+// fillPage - pods of page which match filter. Pods are fetched by the rest of the page size
+// until the page is full or the listing ends; the token of the next page is returned with them.
+func fillPage(
+	page model.PageRequest,
+	match func(coreV1.Pod) bool,
+	fetch func(limit int64, token string) (*coreV1.PodList, error)) ([]coreV1.Pod, string, error) {
+	var (
+		pods  []coreV1.Pod
+		token = page.Token
+	)
+
+	for {
+		podsList, err := fetch(page.Size-int64(len(pods)), token)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, pod := range podsList.Items {
+			if match(pod) {
+				pods = append(pods, pod)
+			}
+		}
+
+		token = podsList.Continue
+		if token == "" || int64(len(pods)) >= page.Size {
+			return pods, token, nil
+		}
+	}
+}
+
+// pageOfParts - page of generators of parts listed one after another, e.g. namespaces or clusters.
+// The page is filled from the next parts if a part ends before it is full.
+// Page token is prefixed with the part the listing is continued in followed by sep.
+func pageOfParts(
+	parts []string,
+	sep string,
+	page model.PageRequest,
+	listPart func(part string, page model.PageRequest) (model.GeneratorsPage, error)) (model.GeneratorsPage, error) {
+	index := 0
+	if page.Token != "" {
+		part, token, found := strings.Cut(page.Token, sep)
+		index = indexOf(parts, part)
+
+		if !found || index < 0 {
+			return model.GeneratorsPage{}, fmt.Errorf("%w: page token %q", ErrInvalidArgument, page.Token)
+		}
+
+		page.Token = token
+	}
+
+	var result model.GeneratorsPage
+
+	for {
+		partPage, err := listPart(parts[index], page)
+		if err != nil {
+			return model.GeneratorsPage{}, err
+		}
+
+		result.Generators = append(result.Generators, partPage.Generators...)
+		page.Size -= int64(len(partPage.Generators))
+
+		switch {
+		case partPage.NextPageToken != "":
+			result.NextPageToken = parts[index] + sep + partPage.NextPageToken
+			return result, nil
+		case index+1 == len(parts):
+			return result, nil
+		case page.Size <= 0:
+			// the next part is listed from its first page
+			result.NextPageToken = parts[index+1] + sep
+			return result, nil
+		}
+
+		index++
+		page.Token = ""
+	}
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// joinServices requests services of pods concurrently; generator of pod without service has no IPs.
+func (m *managerImpl) joinServices(ctx context.Context, pods []coreV1.Pod) ([]model.LoadGenerator, error) {
+	generators := make([]model.LoadGenerator, len(pods))
+	errs := make([]error, len(pods))
+
+	var wg sync.WaitGroup
+	for i := range pods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			service, err := m.client.Get().
+				CoreV1().
+				Services(m.namespace).
+				Get(ctx, pods[i].Name, metaV1.GetOptions{})
+			if err != nil {
+				if !apiErrors.IsNotFound(err) {
+					errs[i] = fmt.Errorf("fail to get service %s: %w", pods[i].Name, err)
+					return
+				}
+
+				service = &coreV1.Service{}
+			}
+
+			generators[i] = makeLoadGenerator(pods[i], *service)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return generators, nil
+}
+
+// phaseSelector - field selector of pods in one of phases; phases are excluded as k8s does not support set of values.
+// Statuses of generators are checked again by filter.
+func phaseSelector(statuses []coreV1.PodPhase) string {
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	allowed := make(map[coreV1.PodPhase]struct{}, len(statuses))
+	for _, status := range statuses {
+		allowed[status] = struct{}{}
+
+		if status == coreV1.PodSucceeded || status == coreV1.PodFailed {
+			// completed generator with artifacts sidecar has running pod; see generatorStatus
+			allowed[coreV1.PodRunning] = struct{}{}
+		}
+	}
+
+	selectors := make([]fields.Selector, 0, len(podPhases))
+	for _, phase := range podPhases {
+		if _, ok := allowed[phase]; !ok {
+			selectors = append(selectors, fields.OneTermNotEqualSelector("status.phase", string(phase)))
+		}
+	}
+
+	return fields.AndSelectors(selectors...).String()
+}
@@ -0,0 +1,179 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPhaseSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []coreV1.PodPhase
+		want     string
+	}{
+		{name: "any status", want: ""},
+		{
+			name:     "running",
+			statuses: []coreV1.PodPhase{coreV1.PodRunning},
+			want:     "status.phase!=Pending,status.phase!=Succeeded,status.phase!=Failed,status.phase!=Unknown",
+		},
+		{
+			name:     "pending and running",
+			statuses: []coreV1.PodPhase{coreV1.PodPending, coreV1.PodRunning},
+			want:     "status.phase!=Succeeded,status.phase!=Failed,status.phase!=Unknown",
+		},
+		{
+			// completed generator with artifacts sidecar has running pod
+			name:     "succeeded",
+			statuses: []coreV1.PodPhase{coreV1.PodSucceeded},
+			want:     "status.phase!=Pending,status.phase!=Failed,status.phase!=Unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, phaseSelector(tt.statuses))
+		})
+	}
+}
+
+// podChunks - fetch of pods of chunks listed one after another like pages of k8s; limits of requests are recorded.
+type podChunks struct {
+	chunks [][]coreV1.Pod
+	limits []int64
+}
+
+func (c *podChunks) fetch(limit int64, token string) (*coreV1.PodList, error) {
+	c.limits = append(c.limits, limit)
+
+	index := 0
+	if token != "" {
+		if _, err := fmt.Sscan(token, &index); err != nil {
+			return nil, err
+		}
+	}
+
+	list := &coreV1.PodList{Items: c.chunks[index]}
+	if index+1 < len(c.chunks) {
+		list.Continue = fmt.Sprint(index + 1)
+	}
+
+	return list, nil
+}
+
+func makePods(names ...string) []coreV1.Pod {
+	pods := make([]coreV1.Pod, 0, len(names))
+	for _, name := range names {
+		pods = append(pods, coreV1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: name}})
+	}
+
+	return pods
+}
+
+func podNames(pods []coreV1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+
+	return names
+}
+
+func TestFillPage(t *testing.T) {
+	skipOdd := func(pod coreV1.Pod) bool {
+		return pod.Name != "b" && pod.Name != "d" && pod.Name != "f"
+	}
+
+	t.Run("short chunks", func(t *testing.T) {
+		chunks := &podChunks{chunks: [][]coreV1.Pod{makePods("a", "b", "c"), makePods("d", "e", "f"), makePods("g")}}
+
+		pods, token, err := fillPage(model.PageRequest{Size: 3}, skipOdd, chunks.fetch)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "c", "e"}, podNames(pods))
+		assert.Equal(t, "2", token)
+		// the rest of the page is requested
+		assert.Equal(t, []int64{3, 1}, chunks.limits)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		chunks := &podChunks{chunks: [][]coreV1.Pod{makePods("a", "b"), makePods("d", "f")}}
+
+		pods, token, err := fillPage(model.PageRequest{Size: 3}, skipOdd, chunks.fetch)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, podNames(pods))
+		assert.Empty(t, token)
+	})
+
+	t.Run("continued", func(t *testing.T) {
+		chunks := &podChunks{chunks: [][]coreV1.Pod{makePods("a"), makePods("b", "c")}}
+
+		pods, token, err := fillPage(model.PageRequest{Size: 3, Token: "1"}, skipOdd, chunks.fetch)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"c"}, podNames(pods))
+		assert.Empty(t, token)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		er := errors.New("some error")
+
+		_, _, err := fillPage(model.PageRequest{Size: 3}, skipOdd, func(int64, string) (*coreV1.PodList, error) {
+			return nil, er
+		})
+		assert.ErrorIs(t, err, er)
+	})
+}
+
+func TestPageOfParts(t *testing.T) {
+	parts := map[string][]model.LoadGenerator{
+		"first":  {{Name: "a"}},
+		"second": {},
+		"third":  {{Name: "b"}, {Name: "c"}, {Name: "d"}},
+	}
+
+	// listPart returns generators of part by pages of its size; token is index of the first generator of page
+	listPart := func(part string, page model.PageRequest) (model.GeneratorsPage, error) {
+		from := 0
+		if page.Token != "" {
+			if _, err := fmt.Sscan(page.Token, &from); err != nil {
+				return model.GeneratorsPage{}, err
+			}
+		}
+
+		generators := parts[part][from:]
+		if int64(len(generators)) <= page.Size {
+			return model.GeneratorsPage{Generators: generators}, nil
+		}
+
+		return model.GeneratorsPage{
+			Generators:    generators[:page.Size],
+			NextPageToken: fmt.Sprint(from + int(page.Size)),
+		}, nil
+	}
+
+	names := []string{"first", "second", "third"}
+
+	page, err := pageOfParts(names, "/", model.PageRequest{Size: 2}, listPart)
+	assert.NoError(t, err)
+	// the page is filled from the next parts
+	assert.Equal(t, []model.LoadGenerator{{Name: "a"}, {Name: "b"}}, page.Generators)
+	assert.Equal(t, "third/1", page.NextPageToken)
+
+	page, err = pageOfParts(names, "/", model.PageRequest{Size: 2, Token: page.NextPageToken}, listPart)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.LoadGenerator{{Name: "c"}, {Name: "d"}}, page.Generators)
+	assert.Empty(t, page.NextPageToken)
+
+	page, err = pageOfParts(names, "/", model.PageRequest{Size: 1}, listPart)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.LoadGenerator{{Name: "a"}}, page.Generators)
+	assert.Equal(t, "second/", page.NextPageToken)
+
+	_, err = pageOfParts(names, "/", model.PageRequest{Size: 1, Token: "unknown/1"}, listPart)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
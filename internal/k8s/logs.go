@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Logs of load generator container. The caller must close the returned stream.
+func (m *managerImpl) Logs(ctx context.Context, name string, opts model.LogOptions) (io.ReadCloser, error) {
+	pod, err := m.getGeneratorPod(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	logOpts := coreV1.PodLogOptions{
+		Follow:     opts.Follow,
+		Timestamps: opts.Timestamps,
+	}
+
+	if len(pod.Spec.Containers) > 0 {
+		logOpts.Container = pod.Spec.Containers[0].Name
+	}
+
+	if opts.TailLines > 0 {
+		tailLines := opts.TailLines
+		logOpts.TailLines = &tailLines
+	}
+
+	if !opts.SinceTime.IsZero() {
+		sinceTime := metaV1.NewTime(opts.SinceTime)
+		logOpts.SinceTime = &sinceTime
+	}
+
+	logs, err := m.client.Get().
+		CoreV1().
+		Pods(m.namespace).
+		GetLogs(name, &logOpts).
+		Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to stream logs of pod %s: %w", name, err)
+	}
+
+	return logs, nil
+}
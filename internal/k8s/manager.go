@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,9 +14,10 @@ import (
 	"go.uber.org/zap"
 	coreV1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -24,28 +26,101 @@ const (
 	creationTimeoutConfigKey  = "kubernetes.timeouts.create"
 	deletionTimeoutConfigKey  = "kubernetes.timeouts.delete"
 	namespaceKey              = "kubernetes.namespace"
+	namespacesKey             = "kubernetes.namespaces"
 	checkPodReadinessInterval = time.Second * 5
 	getExternalIPAttempts     = 5
 	getExternalIPInterval     = time.Second * 5
 )
 
+var (
+	// ErrNotFound - load-generator with requested name does not exist.
+	ErrNotFound = errors.New("load generator not found")
+	// ErrResourceVersionExpired - requested resource version is too old to resume watching from.
+	ErrResourceVersionExpired = errors.New("resource version expired")
+	// ErrAlreadyExists - load-generator with the same idempotency key is being created concurrently.
+	ErrAlreadyExists = errors.New("load generator already exists")
+	// ErrInvalidArgument - parameters of request can not be applied to k8s entities.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrRunNotFound - run with requested id has no generators.
+	ErrRunNotFound = errors.New("run not found")
+	// ErrPageTokenExpired - k8s can not continue listing from requested page token anymore.
+	ErrPageTokenExpired = errors.New("page token expired")
+)
+
 //go:generate mockgen -source=./manager.go -destination=./mock/manager.go
 
 // Manager - k8s manager.
 type Manager interface {
 	Create(ctx context.Context, cfg CreationConfig) (*model.LoadGenerator, error)
 	Delete(ctx context.Context, name string) error
-	DeleteAll(ctx context.Context) error
-	List(ctx context.Context) ([]model.LoadGenerator, error)
+	DeleteAll(ctx context.Context, namespace string) error
+	List(ctx context.Context, filter model.GeneratorFilter) ([]model.LoadGenerator, error)
+	ListPage(ctx context.Context, filter model.GeneratorFilter, page model.PageRequest) (model.GeneratorsPage, error)
+	GetRun(ctx context.Context, id string) (*model.Run, error)
+	ListRuns(ctx context.Context) ([]model.Run, error)
+	DeleteRun(ctx context.Context, id string) error
+	Render(ctx context.Context, cfg CreationConfig, opts model.RenderOptions) (*model.RenderedGenerator, error)
+	Get(ctx context.Context, name string) (*model.GeneratorDetails, error)
+	Exists(ctx context.Context, name string) (bool, error)
+	Watch(ctx context.Context, resourceVersion string, handle func(model.GeneratorEvent) error) error
+	Logs(ctx context.Context, name string, opts model.LogOptions) (io.ReadCloser, error)
+	ListByIdempotencyKey(ctx context.Context, key string) ([]model.LoadGenerator, error)
+	RenewLease(ctx context.Context, name string, duration time.Duration) (*model.LoadGenerator, error)
+	Archive(ctx context.Context, name string) (io.ReadCloser, error)
 }
 
 // CreationConfig for load-generator deploying.
 type CreationConfig struct {
-	Image            string
+	// Cluster of generator; the default cluster if empty.
+	Cluster string
+	// Namespace of generator; default namespace if empty.
+	Namespace string
+	Image     string
+	// ImagePullPolicy - Always, IfNotPresent or Never; Always if empty.
+	ImagePullPolicy string
+	// ImagePullSecrets - secrets with credentials of private registries.
+	ImagePullSecrets []string
 	Resources        model.Resources
 	Envs             []model.EnvVar
-	Commands         []string
+	// EnvFrom - secrets and config maps imported as environment variables; Envs take precedence over them.
+	EnvFrom    []model.EnvFrom
+	Commands   []string
+	Args       []string
+	WorkingDir string
+	// Ports - ports of generator container and service; port from config is used if empty.
+	Ports []model.Port
+	// ReadinessProbe - probe of generator application; creation waits until the generator is ready.
+	ReadinessProbe *model.Probe
+	// Scheduling - constraints of nodes to run generator on.
+	Scheduling model.Scheduling
+	// SpreadGroup - generators of the group are spread according to Scheduling.Spread; one group per creation request.
+	SpreadGroup      string
 	ExposeExternalIP bool
+	// Labels and Annotations are set to all k8s entities of generator.
+	Labels      map[string]string
+	Annotations map[string]string
+	// TTL - lifetime of generator; global ttl of outdated cleaner is applied if zero.
+	TTL time.Duration
+	// Lease - duration of generator lease started on creation; generator has no lease if zero.
+	Lease time.Duration
+	// Artifacts - files of generator to keep for collection before deletion.
+	Artifacts ArtifactsConfig
+	// Files - files mounted into generator container from ConfigMap of generator.
+	Files []model.File
+	// Ammo - files of ammo storage mounted into generator container from AmmoVolume.
+	Ammo       []model.AmmoMount
+	AmmoVolume AmmoVolume
+	// RunID and RunName - run the generator belongs to; optional.
+	RunID   string
+	RunName string
+	// IdempotencyKey - client-supplied key of creation request; optional.
+	IdempotencyKey string
+	// RequestHash - hash of creation request; retries with the same IdempotencyKey and another request are rejected.
+	RequestHash string
+	// Index of generator parameters in creation request; used together with IdempotencyKey.
+	Index int
+	// Progress is called on every observed change of creation stage; optional.
+	Progress func(model.GeneratorProgress)
 }
 
 type managerImpl struct {
@@ -55,10 +130,12 @@ type managerImpl struct {
 	logger    *zap.Logger
 }
 
-// NewManager constructor for Manager.
+// NewManager constructor for Manager of generators of the default namespace kubernetes.namespace
+// and additional namespaces kubernetes.namespaces.
 func NewManager(client Client, config config.Manager, logger *zap.Logger) (Manager, error) {
 	var (
 		namespace, label string
+		namespaces       []string
 		err              error
 		port             int32
 	)
@@ -67,6 +144,10 @@ func NewManager(client Client, config config.Manager, logger *zap.Logger) (Manag
 		err = multierr.Append(err, er)
 	}
 
+	if er := config.UnmarshalKey(namespacesKey, &namespaces); er != nil {
+		err = multierr.Append(err, er)
+	}
+
 	if er := config.UnmarshalKey(lgLabelKey, &label); er != nil {
 		err = multierr.Append(err, er)
 	}
@@ -75,12 +156,15 @@ func NewManager(client Client, config config.Manager, logger *zap.Logger) (Manag
 		err = multierr.Append(err, er)
 	}
 
-	return &managerImpl{
-		client:    client,
-		namespace: namespace,
-		config:    config,
-		logger:    logger,
-	}, err
+	return newNamespacedManager(client, config, logger, append([]string{namespace}, namespaces...)), err
+}
+
+// DefaultNamespace - namespace of generators created without namespace.
+func DefaultNamespace(config config.Manager) string {
+	var namespace string
+	_ = config.UnmarshalKey(namespaceKey, &namespace)
+
+	return namespace
 }
 
 func (m *managerImpl) setCreationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -104,11 +188,30 @@ func (m *managerImpl) Create(ctx context.Context, cfg CreationConfig) (*model.Lo
 	ctx, cancel := m.setCreationTimeout(ctx)
 	defer cancel()
 
-	objMeta, err := m.makeObjectMeta()
+	objMeta, err := m.makeObjectMeta(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	configMap, err := buildConfigMap(objMeta, cfg.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.validateEnvSources(ctx, cfg); err != nil {
+		return nil, err
+	}
+
+	if err = m.validatePullSecrets(ctx, cfg.ImagePullSecrets); err != nil {
+		return nil, err
+	}
+
+	if err = m.validateAmmoClaim(ctx, cfg.AmmoVolume, cfg.Ammo); err != nil {
+		return nil, err
+	}
+
+	cfg.report(model.GeneratorProgress{Name: objMeta.Name, Stage: model.StagePending})
+
 	var (
 		lgService *coreV1.Service
 		lgPod     *coreV1.Pod
@@ -116,31 +219,41 @@ func (m *managerImpl) Create(ctx context.Context, cfg CreationConfig) (*model.Lo
 
 	defer func() {
 		if err != nil {
-			// clear k8s resources if failed
-			go func(name string) {
-				if er := m.Delete(context.Background(), name); er != nil {
-					m.logger.Warn("fail to delete k8s entities for generator", zap.Error(er), zap.String("generator_name", name))
-				}
-			}(objMeta.Name)
+			// clear k8s resources if failed; Create returns only after they are deleted
+			if er := m.Delete(context.Background(), objMeta.Name); er != nil {
+				m.logger.Warn("fail to delete k8s entities for generator", zap.Error(er), zap.String("generator_name", objMeta.Name))
+			}
 		}
 	}()
 
-	var port int32
-	if err = m.config.UnmarshalKey(lgPortKey, &port); err != nil {
-		return nil, fmt.Errorf("fail to define generator port: %w", err)
+	ports, err := m.generatorPorts(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	_, err = m.createIngress(ctx, objMeta)
 	if err != nil {
+		if apiErrors.IsAlreadyExists(err) {
+			// entities belong to concurrent request with the same idempotency key and must not be deleted
+			err = nil
+			return nil, fmt.Errorf("%w: %s", ErrAlreadyExists, objMeta.Name)
+		}
+
 		return nil, fmt.Errorf("failed to create ingress: %w", err)
 	}
 
-	lgService, err = m.createService(ctx, objMeta, port)
+	lgService, err = m.createService(ctx, objMeta, ports)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create service: %w", err)
 	}
 
-	lgPod, err = m.createPod(ctx, cfg, objMeta, port)
+	if configMap != nil {
+		if err = m.createConfigMap(ctx, configMap); err != nil {
+			return nil, fmt.Errorf("failed to create config map: %w", err)
+		}
+	}
+
+	lgPod, err = m.createPod(ctx, cfg, objMeta, ports)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pod: %w", err)
 	}
@@ -153,76 +266,89 @@ func (m *managerImpl) Create(ctx context.Context, cfg CreationConfig) (*model.Lo
 		}
 	}
 
-	pod := model.LoadGenerator{
-		Name:       lgPod.Name,
-		ClusterIP:  lgService.Spec.ClusterIP,
-		ExternalIP: externalIP,
-		Port:       port,
-		Status:     lgPod.Status.Phase,
-		CreatedAt:  lgPod.CreationTimestamp.Time,
-	}
+	generator := makeLoadGenerator(*lgPod, *lgService)
+	generator.ExternalIP = externalIP
 
-	return &pod, nil
+	return &generator, nil
 }
 
-func (m *managerImpl) makeObjectMeta() (metaV1.ObjectMeta, error) {
-	uuid := uuid.New().String()
-
+func (m *managerImpl) makeObjectMeta(cfg CreationConfig) (metaV1.ObjectMeta, error) {
 	var label string
 	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
 		return metaV1.ObjectMeta{}, fmt.Errorf("fail to define label: %w", err)
 	}
 
-	return metaV1.ObjectMeta{
-		Name: label + "-" + uuid,
-		Labels: map[string]string{
-			label: "",
-		},
-	}, nil
+	if err := validateMetadata(label, cfg.Labels, cfg.Annotations); err != nil {
+		return metaV1.ObjectMeta{}, err
+	}
+
+	objMeta := metaV1.ObjectMeta{
+		Name:        label + "-" + uuid.New().String(),
+		Namespace:   m.namespace,
+		Labels:      make(map[string]string, len(cfg.Labels)+2),
+		Annotations: make(map[string]string, len(cfg.Annotations)+1),
+	}
+
+	for k, v := range cfg.Labels {
+		objMeta.Labels[k] = v
+	}
+
+	for k, v := range cfg.Annotations {
+		objMeta.Annotations[k] = v
+	}
+
+	objMeta.Labels[label] = ""
+
+	if cfg.TTL > 0 {
+		objMeta.Annotations[ttlAnnotation] = cfg.TTL.String()
+	}
+
+	if cfg.Lease > 0 {
+		for key, val := range leaseAnnotations(time.Now(), cfg.Lease) {
+			objMeta.Annotations[key] = val
+		}
+	}
+
+	if len(cfg.Ammo) > 0 {
+		objMeta.Annotations[ammoAnnotation] = ammoAnnotationValue(cfg.Ammo)
+	}
+
+	if cfg.Scheduling.Spread != model.SpreadNone {
+		objMeta.Labels[spreadGroupLabel] = cfg.SpreadGroup
+	}
+
+	if cfg.RunID != "" {
+		objMeta.Labels[runIDLabel] = cfg.RunID
+		objMeta.Annotations[runNameAnnotation] = cfg.RunName
+	}
+
+	if cfg.IdempotencyKey != "" {
+		objMeta.Name = idempotentName(label, cfg.IdempotencyKey, cfg.Index)
+		objMeta.Labels[idempotencyKeyLabel] = idempotencyHash(cfg.IdempotencyKey)
+		objMeta.Annotations[idempotencyKeyAnnotation] = cfg.IdempotencyKey
+
+		if cfg.RequestHash != "" {
+			objMeta.Annotations[requestHashAnnotation] = cfg.RequestHash
+		}
+	}
+
+	return objMeta, nil
 }
 
 func (m *managerImpl) createPod(
 	ctx context.Context,
 	cfg CreationConfig,
 	objMeta metaV1.ObjectMeta,
-	port int32) (*coreV1.Pod, error) {
-	envs := cfg.Envs
-	envVars := make([]coreV1.EnvVar, 0, len(envs))
-
-	for _, envVariable := range envs {
-		envVars = append(envVars, coreV1.EnvVar{
-			Name:  envVariable.Name,
-			Value: envVariable.Value,
-		})
-	}
-
-	resources, err := defineResources(cfg.Resources)
+	ports []coreV1.ContainerPort) (*coreV1.Pod, error) {
+	podConf, err := buildPod(cfg, objMeta, ports)
 	if err != nil {
 		return nil, err
 	}
 
-	podConf := coreV1.Pod{
-		ObjectMeta: objMeta,
-		Spec: coreV1.PodSpec{
-			RestartPolicy: coreV1.RestartPolicyNever,
-			Containers: []coreV1.Container{{
-				Name:            objMeta.Name,
-				Image:           cfg.Image,
-				ImagePullPolicy: coreV1.PullAlways,
-				Env:             envVars,
-				Command:         cfg.Commands,
-				Resources:       resources,
-				Ports: []coreV1.ContainerPort{{
-					ContainerPort: port,
-				}},
-			}},
-		},
-	}
-
 	lgPod, err := m.client.Get().
 		CoreV1().
 		Pods(m.namespace).
-		Create(ctx, &podConf, metaV1.CreateOptions{})
+		Create(ctx, podConf, metaV1.CreateOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pod %s: %w", objMeta.Name, err)
 	}
@@ -230,28 +356,92 @@ func (m *managerImpl) createPod(
 	for {
 		select {
 		case <-ctx.Done():
-			go func(ctx context.Context, name string) {
-				if er := m.Delete(ctx, name); er != nil {
-					m.logger.Error("fail to delete unready pod "+name, zap.Error(err), zap.String("tank_name", name))
-				}
-			}(context.Background(), objMeta.Name)
-
+			// unready pod is deleted together with other k8s entities of generator in Create
 			return nil, fmt.Errorf("context for pod creation exhausted for tank %s; pod will be deleted. Last status: %+v", objMeta.Name, lgPod.Status)
 		case <-time.After(checkPodReadinessInterval):
-			if lgPod, err = m.client.Get().
+			pod, err := m.client.Get().
 				CoreV1().
 				Pods(m.namespace).
-				Get(ctx, objMeta.Name, metaV1.GetOptions{}); err != nil {
+				Get(ctx, objMeta.Name, metaV1.GetOptions{})
+			if err != nil {
 				m.logger.Warn("tank pod status check failed", zap.String("tank_name", objMeta.Name), zap.Error(err))
+				continue
 			}
 
-			if lgPod.Status.Phase == coreV1.PodRunning {
+			lgPod = pod
+
+			cfg.report(podProgress(lgPod))
+
+			// pod is ready when all its containers are running and pass readiness probes
+			if lgPod.Status.Phase == coreV1.PodRunning && podReady(*lgPod) {
 				return lgPod, nil
 			}
+
+			// pod with artifacts sidecar keeps running after generator container exits
+			if status := generatorStatus(*lgPod); status == coreV1.PodFailed || status == coreV1.PodSucceeded {
+				return nil, fmt.Errorf("%w: %s", errPodFinished, status)
+			}
 		}
 	}
 }
 
+// buildPod builds pod of load-generator container.
+func buildPod(cfg CreationConfig, objMeta metaV1.ObjectMeta, ports []coreV1.ContainerPort) (*coreV1.Pod, error) {
+	resources, err := defineResources(cfg.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	readinessProbe, err := buildProbe(cfg.ReadinessProbe, ports)
+	if err != nil {
+		return nil, err
+	}
+
+	pullPolicy, err := imagePullPolicy(cfg.ImagePullPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &coreV1.Pod{
+		TypeMeta:   metaV1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: objMeta,
+		Spec: coreV1.PodSpec{
+			RestartPolicy:         coreV1.RestartPolicyNever,
+			ActiveDeadlineSeconds: activeDeadlineSeconds(cfg.TTL, cfg.Artifacts),
+			ImagePullSecrets:      buildPullSecrets(cfg.ImagePullSecrets),
+			Containers: []coreV1.Container{{
+				Name:            objMeta.Name,
+				Image:           cfg.Image,
+				ImagePullPolicy: pullPolicy,
+				Env:             buildEnv(cfg.Envs),
+				EnvFrom:         buildEnvFrom(cfg.EnvFrom),
+				Command:         cfg.Commands,
+				Args:            cfg.Args,
+				WorkingDir:      cfg.WorkingDir,
+				Resources:       resources,
+				Ports:           ports,
+				ReadinessProbe:  readinessProbe,
+			}},
+		},
+	}
+
+	if err = addScheduling(&pod.Spec, cfg.Scheduling, cfg.SpreadGroup); err != nil {
+		return nil, err
+	}
+
+	addFiles(&pod.Spec, objMeta.Name, cfg.Files)
+
+	if err = addAmmo(&pod.Spec, cfg.AmmoVolume, cfg.Ammo); err != nil {
+		return nil, err
+	}
+
+	if err = addArtifactsSidecar(&pod.Spec, cfg.Artifacts); err != nil {
+		return nil, err
+	}
+
+	return pod, nil
+}
+
 func defineResources(resources model.Resources) (coreV1.ResourceRequirements, error) {
 	cpuLimit, err := resource.ParseQuantity(resources.CPU.Limit)
 	if err != nil {
@@ -284,29 +474,28 @@ func defineResources(resources model.Resources) (coreV1.ResourceRequirements, er
 
 func (m *managerImpl) createService(ctx context.Context,
 	objMeta metaV1.ObjectMeta,
-	port int32) (*coreV1.Service, error) {
-	svc, err := m.client.Get().CoreV1().Services(m.namespace).Create(ctx, &coreV1.Service{
+	ports []coreV1.ContainerPort) (*coreV1.Service, error) {
+	svc, err := m.client.Get().CoreV1().Services(m.namespace).Create(ctx, buildService(objMeta, ports), metaV1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service %s: %w", objMeta.Name, err)
+	}
+
+	return svc, nil
+}
+
+// buildService builds load balancer service of load-generator pod with named port for every container port.
+func buildService(objMeta metaV1.ObjectMeta, ports []coreV1.ContainerPort) *coreV1.Service {
+	return &coreV1.Service{
+		TypeMeta:   metaV1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: objMeta,
 		Spec: coreV1.ServiceSpec{
 			Type:     coreV1.ServiceTypeLoadBalancer,
 			Selector: objMeta.Labels,
-			Ports: []coreV1.ServicePort{
-				{
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: port,
-					},
-					Port: port,
-				}},
+			Ports:    servicePorts(ports),
 		},
-	}, metaV1.CreateOptions{})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create service %s: %w", objMeta.Name, err)
 	}
-
-	return svc, nil
 }
+
 func (m *managerImpl) waitServiceExternalIP(ctx context.Context, serviceName string) (string, error) {
 	var i int
 
@@ -347,7 +536,18 @@ func (m *managerImpl) getServiceExternalIP(ctx context.Context, serviceName stri
 }
 
 func (m *managerImpl) createIngress(ctx context.Context, objMeta metaV1.ObjectMeta) (*v1.Ingress, error) {
-	ingress, err := m.client.Get().NetworkingV1().Ingresses(m.namespace).Create(ctx, &v1.Ingress{
+	ingress, err := m.client.Get().NetworkingV1().Ingresses(m.namespace).Create(ctx, buildIngress(objMeta), metaV1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ingress %s: %w", objMeta.Name, err)
+	}
+
+	return ingress, nil
+}
+
+// buildIngress builds ingress of load-generator service.
+func buildIngress(objMeta metaV1.ObjectMeta) *v1.Ingress {
+	return &v1.Ingress{
+		TypeMeta:   metaV1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
 		ObjectMeta: objMeta,
 		Spec: v1.IngressSpec{
 			DefaultBackend: &v1.IngressBackend{
@@ -357,27 +557,61 @@ func (m *managerImpl) createIngress(ctx context.Context, objMeta metaV1.ObjectMe
 				},
 			},
 		},
-	}, metaV1.CreateOptions{})
+	}
+}
+
+// List of existing load generators satisfying filter.
+func (m *managerImpl) List(ctx context.Context, filter model.GeneratorFilter) ([]model.LoadGenerator, error) {
+	selector, err := m.labelSelector(filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create ingress %s: %w", objMeta.Name, err)
+		return nil, err
 	}
 
-	return ingress, nil
+	generators, _, err := m.listGenerators(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterGenerators(generators, filter), nil
 }
 
-// List of existing load generators.
-func (m *managerImpl) List(ctx context.Context) ([]model.LoadGenerator, error) {
+// labelSelector - selector of generators combined with label selector of filter.
+func (m *managerImpl) labelSelector(filter model.GeneratorFilter) (string, error) {
 	var label string
 	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
-		return nil, fmt.Errorf("fail to define label: %w", err)
+		return "", fmt.Errorf("fail to define label: %w", err)
+	}
+
+	if filter.LabelSelector == "" {
+		return label, nil
+	}
+
+	if _, err := labels.Parse(filter.LabelSelector); err != nil {
+		return "", fmt.Errorf("%w: label selector: %v", ErrInvalidArgument, err)
+	}
+
+	return label + "," + filter.LabelSelector, nil
+}
+
+func filterGenerators(generators []model.LoadGenerator, filter model.GeneratorFilter) []model.LoadGenerator {
+	filtered := generators[:0]
+	for _, generator := range generators {
+		if filter.Match(generator) {
+			filtered = append(filtered, generator)
+		}
 	}
 
+	return filtered
+}
+
+// listGenerators returns existing load generators and resource version of pods list.
+func (m *managerImpl) listGenerators(ctx context.Context, label string) ([]model.LoadGenerator, string, error) {
 	podsList, err := m.client.Get().
 		CoreV1().
 		Pods(m.namespace).
 		List(ctx, metaV1.ListOptions{LabelSelector: label})
 	if err != nil {
-		return nil, fmt.Errorf("fail to get list of pods: %w", err)
+		return nil, "", fmt.Errorf("fail to get list of pods: %w", err)
 	}
 
 	servicesList, err := m.client.Get().
@@ -385,7 +619,7 @@ func (m *managerImpl) List(ctx context.Context) ([]model.LoadGenerator, error) {
 		Services(m.namespace).
 		List(ctx, metaV1.ListOptions{LabelSelector: label})
 	if err != nil {
-		return nil, fmt.Errorf("fail to get list of services: %w", err)
+		return nil, "", fmt.Errorf("fail to get list of services: %w", err)
 	}
 
 	ipsMp := make(map[string]coreV1.Service)
@@ -396,29 +630,144 @@ func (m *managerImpl) List(ctx context.Context) ([]model.LoadGenerator, error) {
 	generators := make([]model.LoadGenerator, 0, len(podsList.Items))
 
 	for _, pod := range podsList.Items {
-		var externalIP string
+		generators = append(generators, makeLoadGenerator(pod, ipsMp[pod.Name]))
+	}
+
+	return generators, podsList.ResourceVersion, nil
+}
+
+// makeLoadGenerator joins generator pod with its service.
+func makeLoadGenerator(pod coreV1.Pod, service coreV1.Service) model.LoadGenerator {
+	var externalIP string
+	if len(service.Status.LoadBalancer.Ingress) > 0 {
+		externalIP = service.Status.LoadBalancer.Ingress[0].IP
+	}
+
+	var image string
+	if len(pod.Spec.Containers) > 0 {
+		image = pod.Spec.Containers[0].Image
+	}
+
+	var port int32
+	ports := generatorPortsOf(pod)
+	if len(ports) > 0 {
+		port = ports[0].Port
+	}
+
+	return model.LoadGenerator{
+		Name:           pod.Name,
+		Namespace:      pod.Namespace,
+		ClusterIP:      service.Spec.ClusterIP,
+		ExternalIP:     externalIP,
+		Port:           port,
+		Ports:          ports,
+		Status:         generatorStatus(pod),
+		Ready:          podReady(pod),
+		Labels:         pod.Labels,
+		Annotations:    pod.Annotations,
+		Image:          image,
+		Resources:      generatorResources(pod),
+		TTL:            generatorTTL(pod.Annotations),
+		LeaseExpiresAt: leaseExpiresAt(pod.Annotations),
+		AmmoIDs:        ammoIDs(pod.Annotations),
+		RequestHash:    pod.Annotations[requestHashAnnotation],
+		CreatedAt:      pod.CreationTimestamp.Time,
+	}
+}
+
+// generatorResources - requests and limits of cpu and memory of all containers of generator pod.
+func generatorResources(pod coreV1.Pod) model.Resources {
+	limits, requests := coreV1.ResourceList{}, coreV1.ResourceList{}
 
-		service := ipsMp[pod.Name]
-		if len(service.Status.LoadBalancer.Ingress) > 0 {
-			externalIP = service.Status.LoadBalancer.Ingress[0].IP
+	// artifacts sidecar takes resources together with generator container
+	for _, container := range pod.Spec.Containers {
+		for name, q := range container.Resources.Limits {
+			total := limits[name]
+			total.Add(q)
+			limits[name] = total
 		}
 
-		var port int32
-		if len(pod.Spec.Containers) > 0 && len(pod.Spec.Containers[0].Ports) > 0 {
-			port = pod.Spec.Containers[0].Ports[0].ContainerPort
+		for name, q := range container.Resources.Requests {
+			total := requests[name]
+			total.Add(q)
+			requests[name] = total
 		}
+	}
 
-		generators = append(generators, model.LoadGenerator{
-			Name:       pod.Name,
-			ClusterIP:  service.Spec.ClusterIP,
-			ExternalIP: externalIP,
-			Port:       port,
-			Status:     pod.Status.Phase,
-			CreatedAt:  pod.CreationTimestamp.Time,
-		})
+	quantity := func(list coreV1.ResourceList, name coreV1.ResourceName) string {
+		if q, ok := list[name]; ok {
+			return q.String()
+		}
+
+		return ""
+	}
+
+	return model.Resources{
+		CPU: model.Resource{
+			Limit:   quantity(limits, coreV1.ResourceCPU),
+			Request: quantity(requests, coreV1.ResourceCPU),
+		},
+		Memory: model.Resource{
+			Limit:   quantity(limits, coreV1.ResourceMemory),
+			Request: quantity(requests, coreV1.ResourceMemory),
+		},
+	}
+}
+
+// Exists - load generator with name exists; its pod is requested rather than details of Get.
+// Service of generator is checked if the pod is gone, so remaining entities of the generator are still found for deletion.
+func (m *managerImpl) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := m.getGeneratorPod(ctx, name)
+	if !errors.Is(err, ErrNotFound) {
+		return err == nil, err
+	}
+
+	var label string
+	if err = m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+		return false, fmt.Errorf("fail to define label: %w", err)
+	}
+
+	service, err := m.client.Get().
+		CoreV1().
+		Services(m.namespace).
+		Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("fail to get service %s: %w", name, err)
+	}
+
+	_, ok := service.Labels[label]
+
+	return ok, nil
+}
+
+// getGeneratorPod returns pod of load generator; ErrNotFound is returned for pods not managed by the operator.
+func (m *managerImpl) getGeneratorPod(ctx context.Context, name string) (*coreV1.Pod, error) {
+	var label string
+	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+		return nil, fmt.Errorf("fail to define label: %w", err)
+	}
+
+	pod, err := m.client.Get().
+		CoreV1().
+		Pods(m.namespace).
+		Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+
+		return nil, fmt.Errorf("fail to get pod %s: %w", name, err)
 	}
 
-	return generators, nil
+	if _, ok := pod.Labels[label]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	return pod, nil
 }
 
 // Delete load generator by name.
@@ -441,31 +790,37 @@ func (m *managerImpl) Delete(ctx context.Context, name string) error {
 		Ingresses(m.namespace).
 		Delete(ctx, name, metaV1.DeleteOptions{})
 
-	return multierr.Combine(errPod, errService, errIngress)
+	errConfigMap := m.deleteConfigMap(ctx, name)
+
+	return multierr.Combine(errPod, errService, errIngress, errConfigMap)
 }
 
-// DeleteAll generators.
+// DeleteAll generators of the namespace.
 func (m *managerImpl) DeleteAll(ctx context.Context) error {
-	ctx, cancel := m.setDeletionTimeout(context.Background())
-	defer cancel()
-
 	var label string
-	var err error
-	if err = m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
 		return fmt.Errorf("fail to define label: %w", err)
 	}
 
+	return m.deleteBySelector(ctx, label)
+}
+
+// deleteBySelector deletes pods, services, ingresses and config maps matching label selector.
+func (m *managerImpl) deleteBySelector(ctx context.Context, selector string) error {
+	ctx, cancel := m.setDeletionTimeout(ctx)
+	defer cancel()
+
 	errPod := m.client.Get().
 		CoreV1().
 		Pods(m.namespace).
 		DeleteCollection(ctx, metaV1.DeleteOptions{}, metaV1.ListOptions{
-			LabelSelector: label,
+			LabelSelector: selector,
 		})
 
 	servicesList, errService := m.client.Get().
 		CoreV1().
 		Services(m.namespace).
-		List(ctx, metaV1.ListOptions{LabelSelector: label})
+		List(ctx, metaV1.ListOptions{LabelSelector: selector})
 	if errService == nil {
 		for _, svc := range servicesList.Items {
 			if er := m.client.Get().
@@ -476,17 +831,24 @@ func (m *managerImpl) DeleteAll(ctx context.Context) error {
 			}
 		}
 	} else {
-		errService = fmt.Errorf("fail to get list of services: %w", err)
+		errService = fmt.Errorf("fail to get list of services: %w", errService)
 	}
 
 	errIngress := m.client.Get().
 		NetworkingV1().
 		Ingresses(m.namespace).
 		DeleteCollection(ctx, metaV1.DeleteOptions{}, metaV1.ListOptions{
-			LabelSelector: label,
+			LabelSelector: selector,
+		})
+
+	errConfigMap := m.client.Get().
+		CoreV1().
+		ConfigMaps(m.namespace).
+		DeleteCollection(ctx, metaV1.DeleteOptions{}, metaV1.ListOptions{
+			LabelSelector: selector,
 		})
 
-	return multierr.Combine(errPod, errService, errIngress)
+	return multierr.Combine(errPod, errService, errIngress, errConfigMap)
 }
 
 func (m *managerImpl) setDeletionTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
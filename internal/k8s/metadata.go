@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
+	apiValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// prefix of labels and annotations reserved for the operator.
+	reservedPrefix = "lg-operator/"
+)
+
+// validateMetadata - user labels and annotations must be valid for k8s and must not override the operator's ones.
+func validateMetadata(label string, labels, annotations map[string]string) error {
+	var err error
+
+	for k, v := range labels {
+		if k == label || strings.HasPrefix(k, reservedPrefix) {
+			err = multierr.Append(err, fmt.Errorf("label %q is reserved", k))
+			continue
+		}
+
+		for _, msg := range validation.IsQualifiedName(k) {
+			err = multierr.Append(err, fmt.Errorf("label %q: %s", k, msg))
+		}
+
+		for _, msg := range validation.IsValidLabelValue(v) {
+			err = multierr.Append(err, fmt.Errorf("label %q value: %s", k, msg))
+		}
+	}
+
+	for k := range annotations {
+		if strings.HasPrefix(k, reservedPrefix) {
+			err = multierr.Append(err, fmt.Errorf("annotation %q is reserved", k))
+		}
+	}
+
+	for _, e := range apiValidation.ValidateAnnotations(annotations, field.NewPath("annotations")) {
+		err = multierr.Append(err, e)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return nil
+}
@@ -6,11 +6,13 @@ package mock_k8s
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
-	k8s  "github.com/spirt-t/lg-operator/internal/k8s"
-	model  "github.com/spirt-t/lg-operator/internal/model"
+	k8s "github.com/spirt-t/lg-operator/internal/k8s"
+	model "github.com/spirt-t/lg-operator/internal/model"
 )
 
 // MockManager is a mock of Manager interface.
@@ -36,6 +38,21 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockManager) Archive(ctx context.Context, name string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, name)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockManagerMockRecorder) Archive(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockManager)(nil).Archive), ctx, name)
+}
+
 // Create mocks base method.
 func (m *MockManager) Create(ctx context.Context, cfg k8s.CreationConfig) (*model.LoadGenerator, error) {
 	m.ctrl.T.Helper()
@@ -66,30 +83,193 @@ func (mr *MockManagerMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
 }
 
 // DeleteAll mocks base method.
-func (m *MockManager) DeleteAll(ctx context.Context) error {
+func (m *MockManager) DeleteAll(ctx context.Context, namespace string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAll", ctx)
+	ret := m.ctrl.Call(m, "DeleteAll", ctx, namespace)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteAll indicates an expected call of DeleteAll.
-func (mr *MockManagerMockRecorder) DeleteAll(ctx interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) DeleteAll(ctx, namespace interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockManager)(nil).DeleteAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockManager)(nil).DeleteAll), ctx, namespace)
+}
+
+// DeleteRun mocks base method.
+func (m *MockManager) DeleteRun(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRun", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRun indicates an expected call of DeleteRun.
+func (mr *MockManagerMockRecorder) DeleteRun(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRun", reflect.TypeOf((*MockManager)(nil).DeleteRun), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockManager) Exists(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockManagerMockRecorder) Exists(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockManager)(nil).Exists), ctx, name)
+}
+
+// Get mocks base method.
+func (m *MockManager) Get(ctx context.Context, name string) (*model.GeneratorDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*model.GeneratorDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockManagerMockRecorder) Get(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManager)(nil).Get), ctx, name)
+}
+
+// GetRun mocks base method.
+func (m *MockManager) GetRun(ctx context.Context, id string) (*model.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRun", ctx, id)
+	ret0, _ := ret[0].(*model.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRun indicates an expected call of GetRun.
+func (mr *MockManagerMockRecorder) GetRun(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRun", reflect.TypeOf((*MockManager)(nil).GetRun), ctx, id)
 }
 
 // List mocks base method.
-func (m *MockManager) List(ctx context.Context) ([]model.LoadGenerator, error) {
+func (m *MockManager) List(ctx context.Context, filter model.GeneratorFilter) ([]model.LoadGenerator, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]model.LoadGenerator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockManagerMockRecorder) List(ctx interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockManager)(nil).List), ctx, filter)
+}
+
+// ListByIdempotencyKey mocks base method.
+func (m *MockManager) ListByIdempotencyKey(ctx context.Context, key string) ([]model.LoadGenerator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].([]model.LoadGenerator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIdempotencyKey indicates an expected call of ListByIdempotencyKey.
+func (mr *MockManagerMockRecorder) ListByIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIdempotencyKey", reflect.TypeOf((*MockManager)(nil).ListByIdempotencyKey), ctx, key)
+}
+
+// ListPage mocks base method.
+func (m *MockManager) ListPage(ctx context.Context, filter model.GeneratorFilter, page model.PageRequest) (model.GeneratorsPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPage", ctx, filter, page)
+	ret0, _ := ret[0].(model.GeneratorsPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPage indicates an expected call of ListPage.
+func (mr *MockManagerMockRecorder) ListPage(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPage", reflect.TypeOf((*MockManager)(nil).ListPage), ctx, filter, page)
+}
+
+// ListRuns mocks base method.
+func (m *MockManager) ListRuns(ctx context.Context) ([]model.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx)
+	ret0, _ := ret[0].([]model.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockManagerMockRecorder) ListRuns(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockManager)(nil).ListRuns), ctx)
+}
+
+// Logs mocks base method.
+func (m *MockManager) Logs(ctx context.Context, name string, opts model.LogOptions) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", ctx, name, opts)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockManagerMockRecorder) Logs(ctx, name, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockManager)(nil).Logs), ctx, name, opts)
+}
+
+// Render mocks base method.
+func (m *MockManager) Render(ctx context.Context, cfg k8s.CreationConfig, opts model.RenderOptions) (*model.RenderedGenerator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, cfg, opts)
+	ret0, _ := ret[0].(*model.RenderedGenerator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockManagerMockRecorder) Render(ctx, cfg, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockManager)(nil).Render), ctx, cfg, opts)
+}
+
+// RenewLease mocks base method.
+func (m *MockManager) RenewLease(ctx context.Context, name string, duration time.Duration) (*model.LoadGenerator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLease", ctx, name, duration)
+	ret0, _ := ret[0].(*model.LoadGenerator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewLease indicates an expected call of RenewLease.
+func (mr *MockManagerMockRecorder) RenewLease(ctx, name, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLease", reflect.TypeOf((*MockManager)(nil).RenewLease), ctx, name, duration)
+}
+
+// Watch mocks base method.
+func (m *MockManager) Watch(ctx context.Context, resourceVersion string, handle func(model.GeneratorEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, resourceVersion, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockManagerMockRecorder) Watch(ctx, resourceVersion, handle interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockManager)(nil).List), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockManager)(nil).Watch), ctx, resourceVersion, handle)
 }
@@ -0,0 +1,326 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/config"
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// namespacedManager - manager of generators of several namespaces; the first namespace is the default one.
+// Generators are created in namespace of CreationConfig, generators of all namespaces are listed
+// unless filter has namespace, and generators are found by name in all namespaces.
+type namespacedManager struct {
+	namespaces []string
+	managers   map[string]*managerImpl
+}
+
+func newNamespacedManager(client Client, config config.Manager, logger *zap.Logger, namespaces []string) *namespacedManager {
+	m := &namespacedManager{managers: make(map[string]*managerImpl, len(namespaces))}
+
+	for _, namespace := range namespaces {
+		if _, ok := m.managers[namespace]; ok {
+			continue
+		}
+
+		m.namespaces = append(m.namespaces, namespace)
+		m.managers[namespace] = &managerImpl{
+			client:    client,
+			namespace: namespace,
+			config:    config,
+			logger:    logger.With(zap.String("namespace", namespace)),
+		}
+	}
+
+	return m
+}
+
+// manager of namespace; manager of the default namespace if namespace is empty.
+func (m *namespacedManager) manager(namespace string) (*managerImpl, error) {
+	if namespace == "" {
+		return m.managers[m.namespaces[0]], nil
+	}
+
+	manager, ok := m.managers[namespace]
+	if !ok {
+		return nil, fmt.Errorf("%w: namespace %q is not managed by the operator", ErrInvalidArgument, namespace)
+	}
+
+	return manager, nil
+}
+
+// scope - managers of namespace; managers of all namespaces if namespace is empty.
+func (m *namespacedManager) scope(namespace string) ([]*managerImpl, error) {
+	if namespace != "" {
+		manager, err := m.manager(namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*managerImpl{manager}, nil
+	}
+
+	managers := make([]*managerImpl, 0, len(m.namespaces))
+	for _, ns := range m.namespaces {
+		managers = append(managers, m.managers[ns])
+	}
+
+	return managers, nil
+}
+
+// owner - manager of namespace of generator with name.
+func (m *namespacedManager) owner(ctx context.Context, name string) (*managerImpl, error) {
+	if len(m.namespaces) == 1 {
+		return m.managers[m.namespaces[0]], nil
+	}
+
+	for _, namespace := range m.namespaces {
+		exists, err := m.managers[namespace].Exists(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			return m.managers[namespace], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Create load-generator in namespace of cfg.
+func (m *namespacedManager) Create(ctx context.Context, cfg CreationConfig) (*model.LoadGenerator, error) {
+	manager, err := m.manager(cfg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Create(ctx, cfg)
+}
+
+// Render load-generator in namespace of cfg.
+func (m *namespacedManager) Render(ctx context.Context, cfg CreationConfig, opts model.RenderOptions) (*model.RenderedGenerator, error) {
+	manager, err := m.manager(cfg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Render(ctx, cfg, opts)
+}
+
+// Delete load generator by name.
+func (m *namespacedManager) Delete(ctx context.Context, name string) error {
+	manager, err := m.owner(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return manager.Delete(ctx, name)
+}
+
+// DeleteAll generators of namespace; generators of all namespaces if namespace is empty.
+func (m *namespacedManager) DeleteAll(ctx context.Context, namespace string) error {
+	managers, err := m.scope(namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, manager := range managers {
+		err = multierr.Append(err, manager.DeleteAll(ctx))
+	}
+
+	return err
+}
+
+// List of existing load generators satisfying filter ordered by namespace.
+func (m *namespacedManager) List(ctx context.Context, filter model.GeneratorFilter) ([]model.LoadGenerator, error) {
+	managers, err := m.scope(filter.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var generators []model.LoadGenerator
+
+	for _, manager := range managers {
+		list, err := manager.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		generators = append(generators, list...)
+	}
+
+	return generators, nil
+}
+
+// ListPage returns page of load generators satisfying filter ordered by namespace and name.
+// Page token of several namespaces is prefixed with namespace the listing is continued in.
+func (m *namespacedManager) ListPage(ctx context.Context, filter model.GeneratorFilter, page model.PageRequest) (model.GeneratorsPage, error) {
+	if filter.Namespace != "" || len(m.namespaces) == 1 {
+		manager, err := m.manager(filter.Namespace)
+		if err != nil {
+			return model.GeneratorsPage{}, err
+		}
+
+		return manager.ListPage(ctx, filter, page)
+	}
+
+	return pageOfParts(m.namespaces, "/", page, func(namespace string, page model.PageRequest) (model.GeneratorsPage, error) {
+		return m.managers[namespace].ListPage(ctx, filter, page)
+	})
+}
+
+// GetRun returns run with its generators of all namespaces.
+func (m *namespacedManager) GetRun(ctx context.Context, id string) (*model.Run, error) {
+	var generators []model.LoadGenerator
+
+	for _, namespace := range m.namespaces {
+		run, err := m.managers[namespace].GetRun(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrRunNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		generators = append(generators, run.Generators...)
+	}
+
+	runs := groupRuns(generators)
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return &runs[0], nil
+}
+
+// ListRuns returns runs of all namespaces; the most recent first.
+func (m *namespacedManager) ListRuns(ctx context.Context) ([]model.Run, error) {
+	var generators []model.LoadGenerator
+
+	for _, namespace := range m.namespaces {
+		runs, err := m.managers[namespace].ListRuns(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, run := range runs {
+			generators = append(generators, run.Generators...)
+		}
+	}
+
+	return groupRuns(generators), nil
+}
+
+// DeleteRun deletes generators of run in all namespaces; ErrRunNotFound is returned if run has no generators.
+func (m *namespacedManager) DeleteRun(ctx context.Context, id string) error {
+	var (
+		err   error
+		found bool
+	)
+
+	for _, namespace := range m.namespaces {
+		er := m.managers[namespace].DeleteRun(ctx, id)
+		if errors.Is(er, ErrRunNotFound) {
+			continue
+		}
+
+		found = true
+		err = multierr.Append(err, er)
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return err
+}
+
+// Exists - generator with name exists in any namespace.
+func (m *namespacedManager) Exists(ctx context.Context, name string) (bool, error) {
+	for _, namespace := range m.namespaces {
+		exists, err := m.managers[namespace].Exists(ctx, name)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+
+	return false, nil
+}
+
+// Get generator by name.
+func (m *namespacedManager) Get(ctx context.Context, name string) (*model.GeneratorDetails, error) {
+	manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Get(ctx, name)
+}
+
+// Watch changes of load generators of all namespaces. Namespaces are watched concurrently, while handle
+// is called sequentially. Resource version of event joins the last versions of all namespaces,
+// e.g. "team-a:1042;team-b:977"; it is nested into resource version of clusters, so other separators are used.
+func (m *namespacedManager) Watch(ctx context.Context, resourceVersion string, handle func(model.GeneratorEvent) error) error {
+	if len(m.namespaces) == 1 {
+		return m.managers[m.namespaces[0]].Watch(ctx, resourceVersion, handle)
+	}
+
+	return watchParts(ctx, m.namespaces, ";", ":", resourceVersion, handle,
+		func(ctx context.Context, namespace, from string, handle func(model.GeneratorEvent) error) error {
+			return m.managers[namespace].Watch(ctx, from, handle)
+		})
+}
+
+// Logs of generator by name.
+func (m *namespacedManager) Logs(ctx context.Context, name string, opts model.LogOptions) (io.ReadCloser, error) {
+	manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Logs(ctx, name, opts)
+}
+
+// ListByIdempotencyKey returns generators created with idempotency key in all namespaces.
+func (m *namespacedManager) ListByIdempotencyKey(ctx context.Context, key string) ([]model.LoadGenerator, error) {
+	var generators []model.LoadGenerator
+
+	for _, namespace := range m.namespaces {
+		list, err := m.managers[namespace].ListByIdempotencyKey(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		generators = append(generators, list...)
+	}
+
+	return generators, nil
+}
+
+// RenewLease of generator by name.
+func (m *namespacedManager) RenewLease(ctx context.Context, name string, duration time.Duration) (*model.LoadGenerator, error) {
+	manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.RenewLease(ctx, name, duration)
+}
+
+// Archive artifacts of generator by name.
+func (m *namespacedManager) Archive(ctx context.Context, name string) (io.ReadCloser, error) {
+	manager, err := m.owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Archive(ctx, name)
+}
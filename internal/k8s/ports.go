@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// defaultPortName - name of port from config used if creation config has no ports.
+	defaultPortName = "main"
+)
+
+// generatorPorts - container ports of generator; port from config is used if cfg has no ports.
+func (m *managerImpl) generatorPorts(cfg CreationConfig) ([]coreV1.ContainerPort, error) {
+	if len(cfg.Ports) > 0 {
+		return buildPorts(cfg.Ports)
+	}
+
+	var port int32
+	if err := m.config.UnmarshalKey(lgPortKey, &port); err != nil {
+		return nil, fmt.Errorf("fail to define generator port: %w", err)
+	}
+
+	return []coreV1.ContainerPort{{Name: defaultPortName, ContainerPort: port, Protocol: coreV1.ProtocolTCP}}, nil
+}
+
+// buildPorts validates ports of generator; names and numbers of ports must be unique.
+func buildPorts(ports []model.Port) ([]coreV1.ContainerPort, error) {
+	var (
+		err     error
+		names   = make(map[string]struct{}, len(ports))
+		numbers = make(map[string]struct{}, len(ports))
+		built   = make([]coreV1.ContainerPort, 0, len(ports))
+	)
+
+	for _, port := range ports {
+		for _, msg := range validation.IsValidPortName(port.Name) {
+			err = multierr.Append(err, fmt.Errorf("port name %q: %s", port.Name, msg))
+		}
+
+		for _, msg := range validation.IsValidPortNum(int(port.Port)) {
+			err = multierr.Append(err, fmt.Errorf("port %q: %s", port.Name, msg))
+		}
+
+		protocol := coreV1.Protocol(strings.ToUpper(port.Protocol))
+		switch protocol {
+		case "":
+			protocol = coreV1.ProtocolTCP
+		case coreV1.ProtocolTCP, coreV1.ProtocolUDP, coreV1.ProtocolSCTP:
+		default:
+			err = multierr.Append(err, fmt.Errorf("protocol %q of port %q is not supported", port.Protocol, port.Name))
+		}
+
+		if _, ok := names[port.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("port name %q is duplicated", port.Name))
+		}
+		names[port.Name] = struct{}{}
+
+		number := fmt.Sprintf("%d/%s", port.Port, protocol)
+		if _, ok := numbers[number]; ok {
+			err = multierr.Append(err, fmt.Errorf("port %s is duplicated", number))
+		}
+		numbers[number] = struct{}{}
+
+		built = append(built, coreV1.ContainerPort{Name: port.Name, ContainerPort: port.Port, Protocol: protocol})
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return built, nil
+}
+
+// servicePorts - ports of service targeting container ports by their names.
+func servicePorts(ports []coreV1.ContainerPort) []coreV1.ServicePort {
+	servicePorts := make([]coreV1.ServicePort, 0, len(ports))
+	for _, port := range ports {
+		servicePorts = append(servicePorts, coreV1.ServicePort{
+			Name:       port.Name,
+			Protocol:   port.Protocol,
+			Port:       port.ContainerPort,
+			TargetPort: intstr.FromString(port.Name),
+		})
+	}
+
+	return servicePorts
+}
+
+// generatorPortsOf - ports of generator container; the first one is the main port.
+func generatorPortsOf(pod coreV1.Pod) []model.Port {
+	if len(pod.Spec.Containers) == 0 || len(pod.Spec.Containers[0].Ports) == 0 {
+		return nil
+	}
+
+	ports := make([]model.Port, 0, len(pod.Spec.Containers[0].Ports))
+	for _, port := range pod.Spec.Containers[0].Ports {
+		ports = append(ports, model.Port{Name: port.Name, Port: port.ContainerPort, Protocol: string(port.Protocol)})
+	}
+
+	return ports
+}
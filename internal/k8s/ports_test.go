@@ -0,0 +1,84 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestBuildPorts(t *testing.T) {
+	tests := []struct {
+		name    string
+		ports   []model.Port
+		want    []coreV1.ContainerPort
+		wantErr string
+	}{
+		{
+			name: "valid",
+			ports: []model.Port{
+				{Name: "http", Port: 8080},
+				{Name: "metrics", Port: 9090, Protocol: "tcp"},
+				{Name: "stats", Port: 9090, Protocol: "UDP"},
+			},
+			want: []coreV1.ContainerPort{
+				{Name: "http", ContainerPort: 8080, Protocol: coreV1.ProtocolTCP},
+				{Name: "metrics", ContainerPort: 9090, Protocol: coreV1.ProtocolTCP},
+				{Name: "stats", ContainerPort: 9090, Protocol: coreV1.ProtocolUDP},
+			},
+		},
+		{
+			name:    "duplicated name",
+			ports:   []model.Port{{Name: "http", Port: 8080}, {Name: "http", Port: 8081}},
+			wantErr: `port name "http" is duplicated`,
+		},
+		{
+			name:    "duplicated number",
+			ports:   []model.Port{{Name: "http", Port: 8080}, {Name: "admin", Port: 8080, Protocol: "TCP"}},
+			wantErr: "port 8080/TCP is duplicated",
+		},
+		{
+			name:    "invalid name",
+			ports:   []model.Port{{Name: "HTTP_PORT", Port: 8080}},
+			wantErr: `port name "HTTP_PORT"`,
+		},
+		{
+			name:    "out of range",
+			ports:   []model.Port{{Name: "http", Port: 70000}},
+			wantErr: `port "http"`,
+		},
+		{
+			name:    "unsupported protocol",
+			ports:   []model.Port{{Name: "http", Port: 8080, Protocol: "quic"}},
+			wantErr: `protocol "quic" of port "http" is not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPorts(tt.ports)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidArgument)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServicePorts(t *testing.T) {
+	got := servicePorts([]coreV1.ContainerPort{
+		{Name: "http", ContainerPort: 8080, Protocol: coreV1.ProtocolTCP},
+		{Name: "stats", ContainerPort: 9090, Protocol: coreV1.ProtocolUDP},
+	})
+
+	assert.Equal(t, []coreV1.ServicePort{
+		{Name: "http", Protocol: coreV1.ProtocolTCP, Port: 8080, TargetPort: intstr.FromString("http")},
+		{Name: "stats", Protocol: coreV1.ProtocolUDP, Port: 9090, TargetPort: intstr.FromString("stats")},
+	}, got)
+}
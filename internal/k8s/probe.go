@@ -0,0 +1,128 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	// errPodFinished - pod of generator has finished before becoming ready.
+	errPodFinished = errors.New("pod finished before becoming ready")
+)
+
+// buildProbe builds readiness probe of generator container; nil if probe is not set.
+func buildProbe(probe *model.Probe, ports []coreV1.ContainerPort) (*coreV1.Probe, error) {
+	if probe == nil {
+		return nil, nil
+	}
+
+	var handlers int
+	for _, set := range []bool{probe.HTTPGet != nil, probe.TCPSocket != nil, probe.Exec != nil} {
+		if set {
+			handlers++
+		}
+	}
+
+	if handlers != 1 {
+		return nil, fmt.Errorf("%w: exactly one of http_get, tcp_socket and exec of readiness probe is required", ErrInvalidArgument)
+	}
+
+	built := &coreV1.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+
+	if built.InitialDelaySeconds < 0 || built.PeriodSeconds < 0 || built.TimeoutSeconds < 0 || built.FailureThreshold < 0 {
+		return nil, fmt.Errorf("%w: parameters of readiness probe must not be negative", ErrInvalidArgument)
+	}
+
+	switch {
+	case probe.HTTPGet != nil:
+		port, err := probePort(probe.HTTPGet.Port, ports)
+		if err != nil {
+			return nil, err
+		}
+
+		probePath := probe.HTTPGet.Path
+		if probePath == "" {
+			probePath = "/"
+		}
+
+		if !path.IsAbs(probePath) {
+			return nil, fmt.Errorf("%w: path %q of readiness probe is not absolute", ErrInvalidArgument, probePath)
+		}
+
+		scheme := coreV1.URIScheme(strings.ToUpper(probe.HTTPGet.Scheme))
+		switch scheme {
+		case "":
+			scheme = coreV1.URISchemeHTTP
+		case coreV1.URISchemeHTTP, coreV1.URISchemeHTTPS:
+		default:
+			return nil, fmt.Errorf("%w: scheme %q of readiness probe is not supported", ErrInvalidArgument, probe.HTTPGet.Scheme)
+		}
+
+		built.HTTPGet = &coreV1.HTTPGetAction{Path: probePath, Port: port, Scheme: scheme}
+	case probe.TCPSocket != nil:
+		port, err := probePort(probe.TCPSocket.Port, ports)
+		if err != nil {
+			return nil, err
+		}
+
+		built.TCPSocket = &coreV1.TCPSocketAction{Port: port}
+	default:
+		if len(probe.Exec.Command) == 0 {
+			return nil, fmt.Errorf("%w: command of readiness probe is required", ErrInvalidArgument)
+		}
+
+		built.Exec = &coreV1.ExecAction{Command: probe.Exec.Command}
+	}
+
+	return built, nil
+}
+
+// probePort - port of probe by number or name of generator port; the main port if empty.
+func probePort(port string, ports []coreV1.ContainerPort) (intstr.IntOrString, error) {
+	if port == "" {
+		if len(ports) == 0 {
+			return intstr.IntOrString{}, fmt.Errorf("%w: port of readiness probe is required", ErrInvalidArgument)
+		}
+
+		return intstr.FromInt(int(ports[0].ContainerPort)), nil
+	}
+
+	if number, err := strconv.Atoi(port); err == nil {
+		if number <= 0 || number > 65535 {
+			return intstr.IntOrString{}, fmt.Errorf("%w: port %d of readiness probe is out of range", ErrInvalidArgument, number)
+		}
+
+		return intstr.FromInt(number), nil
+	}
+
+	for _, p := range ports {
+		if p.Name == port {
+			return intstr.FromString(port), nil
+		}
+	}
+
+	return intstr.IntOrString{}, fmt.Errorf("%w: port %q of readiness probe is not a port of generator", ErrInvalidArgument, port)
+}
+
+// podReady - pod passes readiness probes of all its containers.
+func podReady(pod coreV1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == coreV1.PodReady {
+			return condition.Status == coreV1.ConditionTrue
+		}
+	}
+
+	return false
+}
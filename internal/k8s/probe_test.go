@@ -0,0 +1,132 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestProbePort(t *testing.T) {
+	ports := []coreV1.ContainerPort{
+		{Name: "http", ContainerPort: 8080},
+		{Name: "metrics", ContainerPort: 9090},
+	}
+
+	tests := []struct {
+		name    string
+		port    string
+		ports   []coreV1.ContainerPort
+		want    intstr.IntOrString
+		wantErr string
+	}{
+		{name: "main port", ports: ports, want: intstr.FromInt(8080)},
+		{name: "by name", port: "metrics", ports: ports, want: intstr.FromString("metrics")},
+		{name: "by number", port: "9090", ports: ports, want: intstr.FromInt(9090)},
+		{name: "by number of other port", port: "7000", ports: ports, want: intstr.FromInt(7000)},
+		{name: "unknown name", port: "admin", ports: ports, wantErr: `port "admin" of readiness probe is not a port of generator`},
+		{name: "out of range", port: "70000", ports: ports, wantErr: "port 70000 of readiness probe is out of range"},
+		{name: "no ports", wantErr: "port of readiness probe is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := probePort(tt.port, tt.ports)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidArgument)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildProbe(t *testing.T) {
+	ports := []coreV1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+
+	tests := []struct {
+		name    string
+		probe   *model.Probe
+		want    *coreV1.Probe
+		wantErr string
+	}{
+		{name: "not set"},
+		{
+			name:  "http get by port name",
+			probe: &model.Probe{HTTPGet: &model.HTTPGetProbe{Port: "http", Scheme: "https"}, PeriodSeconds: 5},
+			want: &coreV1.Probe{
+				ProbeHandler: coreV1.ProbeHandler{HTTPGet: &coreV1.HTTPGetAction{
+					Path: "/", Port: intstr.FromString("http"), Scheme: coreV1.URISchemeHTTPS,
+				}},
+				PeriodSeconds: 5,
+			},
+		},
+		{
+			name:  "tcp socket by port number",
+			probe: &model.Probe{TCPSocket: &model.TCPSocketProbe{Port: "8080"}},
+			want: &coreV1.Probe{
+				ProbeHandler: coreV1.ProbeHandler{TCPSocket: &coreV1.TCPSocketAction{Port: intstr.FromInt(8080)}},
+			},
+		},
+		{
+			name:  "exec",
+			probe: &model.Probe{Exec: &model.ExecProbe{Command: []string{"cat", "/tmp/ready"}}},
+			want: &coreV1.Probe{
+				ProbeHandler: coreV1.ProbeHandler{Exec: &coreV1.ExecAction{Command: []string{"cat", "/tmp/ready"}}},
+			},
+		},
+		{
+			name: "several handlers",
+			probe: &model.Probe{
+				HTTPGet:   &model.HTTPGetProbe{},
+				TCPSocket: &model.TCPSocketProbe{},
+			},
+			wantErr: "exactly one of http_get, tcp_socket and exec",
+		},
+		{name: "no handler", probe: &model.Probe{}, wantErr: "exactly one of http_get, tcp_socket and exec"},
+		{
+			name:    "negative parameters",
+			probe:   &model.Probe{TCPSocket: &model.TCPSocketProbe{}, TimeoutSeconds: -1},
+			wantErr: "must not be negative",
+		},
+		{
+			name:    "relative path",
+			probe:   &model.Probe{HTTPGet: &model.HTTPGetProbe{Path: "ready"}},
+			wantErr: `path "ready" of readiness probe is not absolute`,
+		},
+		{
+			name:    "unsupported scheme",
+			probe:   &model.Probe{HTTPGet: &model.HTTPGetProbe{Scheme: "ftp"}},
+			wantErr: `scheme "ftp" of readiness probe is not supported`,
+		},
+		{
+			name:    "unknown port name",
+			probe:   &model.Probe{TCPSocket: &model.TCPSocketProbe{Port: "admin"}},
+			wantErr: `port "admin" of readiness probe is not a port of generator`,
+		},
+		{
+			name:    "exec without command",
+			probe:   &model.Probe{Exec: &model.ExecProbe{}},
+			wantErr: "command of readiness probe is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildProbe(tt.probe, ports)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidArgument)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
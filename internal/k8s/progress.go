@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func (cfg CreationConfig) report(progress model.GeneratorProgress) {
+	if cfg.Progress != nil {
+		cfg.Progress(progress)
+	}
+}
+
+// podProgress defines stage of generator creation by status of its pod.
+func podProgress(pod *coreV1.Pod) model.GeneratorProgress {
+	progress := model.GeneratorProgress{
+		Name:  pod.Name,
+		Stage: model.StagePending,
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != coreV1.PodScheduled {
+			continue
+		}
+
+		if condition.Status == coreV1.ConditionTrue {
+			progress.Stage = model.StageScheduled
+		} else {
+			progress.Message = condition.Message
+		}
+	}
+
+	if status := generatorContainerStatus(*pod); status != nil && status.State.Waiting != nil {
+		progress.Stage = model.StagePullingImage
+		progress.Message = status.State.Waiting.Reason
+	}
+
+	switch status := generatorStatus(*pod); status {
+	case coreV1.PodRunning:
+		progress.Stage = model.StageRunning
+		if !podReady(*pod) {
+			progress.Stage = model.StageStarting
+			progress.Message = "waiting for readiness of generator"
+		}
+	case coreV1.PodFailed, coreV1.PodSucceeded:
+		progress.Stage = model.StageFailed
+		progress.Message = "pod finished before becoming ready: " + string(status)
+	}
+
+	return progress
+}
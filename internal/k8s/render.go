@@ -0,0 +1,149 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	coreV1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// renderedObject - k8s entity of generator to render.
+type renderedObject struct {
+	kind string
+	obj  interface{}
+}
+
+// Render builds pod, service, ingress and config map of load-generator exactly as Create does, but does not create them.
+// With server dry-run the entities are sent to k8s API in dry-run mode and rendered as admitted by k8s.
+func (m *managerImpl) Render(ctx context.Context, cfg CreationConfig, opts model.RenderOptions) (*model.RenderedGenerator, error) {
+	objMeta, err := m.makeObjectMeta(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := m.generatorPorts(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap, err := buildConfigMap(objMeta, cfg.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := buildPod(cfg, objMeta, ports)
+	if err != nil {
+		return nil, err
+	}
+
+	service := buildService(objMeta, ports)
+	ingress := buildIngress(objMeta)
+
+	if opts.ServerDryRun {
+		if err = m.validateEnvSources(ctx, cfg); err != nil {
+			return nil, err
+		}
+
+		if err = m.validatePullSecrets(ctx, cfg.ImagePullSecrets); err != nil {
+			return nil, err
+		}
+
+		if pod, service, ingress, configMap, err = m.dryRun(ctx, pod, service, ingress, configMap); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered := &model.RenderedGenerator{Name: objMeta.Name}
+
+	objects := []renderedObject{
+		{kind: pod.Kind, obj: pod},
+		{kind: service.Kind, obj: service},
+		{kind: ingress.Kind, obj: ingress},
+	}
+
+	if configMap != nil {
+		objects = append(objects, renderedObject{kind: configMap.Kind, obj: configMap})
+	}
+
+	for _, obj := range objects {
+		content, err := marshalManifest(obj.obj, opts.Format)
+		if err != nil {
+			return nil, fmt.Errorf("fail to render %s: %w", obj.kind, err)
+		}
+
+		rendered.Manifests = append(rendered.Manifests, model.Manifest{Kind: obj.kind, Content: content})
+	}
+
+	return rendered, nil
+}
+
+// dryRun creates entities in dry-run mode in order of Create; type meta is restored as k8s does not return it.
+// Config map is optional.
+func (m *managerImpl) dryRun(
+	ctx context.Context,
+	pod *coreV1.Pod,
+	service *coreV1.Service,
+	ingress *v1.Ingress,
+	configMap *coreV1.ConfigMap) (*coreV1.Pod, *coreV1.Service, *v1.Ingress, *coreV1.ConfigMap, error) {
+	opts := metaV1.CreateOptions{DryRun: []string{metaV1.DryRunAll}}
+
+	admittedIngress, err := m.client.Get().NetworkingV1().Ingresses(m.namespace).Create(ctx, ingress, opts)
+	if err != nil {
+		return nil, nil, nil, nil, dryRunError(ingress.Kind, err)
+	}
+	admittedIngress.TypeMeta = ingress.TypeMeta
+
+	admittedService, err := m.client.Get().CoreV1().Services(m.namespace).Create(ctx, service, opts)
+	if err != nil {
+		return nil, nil, nil, nil, dryRunError(service.Kind, err)
+	}
+	admittedService.TypeMeta = service.TypeMeta
+
+	if configMap != nil {
+		admittedConfigMap, err := m.client.Get().CoreV1().ConfigMaps(m.namespace).Create(ctx, configMap, opts)
+		if err != nil {
+			return nil, nil, nil, nil, dryRunError(configMap.Kind, err)
+		}
+		admittedConfigMap.TypeMeta = configMap.TypeMeta
+		configMap = admittedConfigMap
+	}
+
+	admittedPod, err := m.client.Get().CoreV1().Pods(m.namespace).Create(ctx, pod, opts)
+	if err != nil {
+		return nil, nil, nil, nil, dryRunError(pod.Kind, err)
+	}
+	admittedPod.TypeMeta = pod.TypeMeta
+
+	return admittedPod, admittedService, admittedIngress, configMap, nil
+}
+
+// dryRunError - rejection of entity by k8s is caused by parameters of request.
+func dryRunError(kind string, err error) error {
+	if apiErrors.IsInvalid(err) || apiErrors.IsForbidden(err) || apiErrors.IsBadRequest(err) {
+		return fmt.Errorf("%w: %s is rejected by k8s: %v", ErrInvalidArgument, kind, err)
+	}
+
+	return fmt.Errorf("fail to dry-run creation of %s: %w", kind, err)
+}
+
+func marshalManifest(obj interface{}, format model.ManifestFormat) (string, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	switch format {
+	case model.ManifestJSON:
+		content, err = json.MarshalIndent(obj, "", "  ")
+	default:
+		content, err = yaml.Marshal(obj)
+	}
+
+	return string(content), err
+}
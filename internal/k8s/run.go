@@ -0,0 +1,124 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	runIDLabel        = "lg-operator/run-id"
+	runNameAnnotation = "lg-operator/run-name"
+)
+
+// GetRun returns run with its generators; ErrRunNotFound is returned if run has no generators.
+func (m *managerImpl) GetRun(ctx context.Context, id string) (*model.Run, error) {
+	selector, err := m.runSelector(id)
+	if err != nil {
+		return nil, err
+	}
+
+	generators, _, err := m.listGenerators(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := groupRuns(generators)
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return &runs[0], nil
+}
+
+// ListRuns returns all runs; the most recent first.
+func (m *managerImpl) ListRuns(ctx context.Context) ([]model.Run, error) {
+	selector, err := m.runSelector("")
+	if err != nil {
+		return nil, err
+	}
+
+	generators, _, err := m.listGenerators(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupRuns(generators), nil
+}
+
+// DeleteRun deletes all generators of run; ErrRunNotFound is returned if run has no generators.
+func (m *managerImpl) DeleteRun(ctx context.Context, id string) error {
+	selector, err := m.runSelector(id)
+	if err != nil {
+		return err
+	}
+
+	generators, _, err := m.listGenerators(ctx, selector)
+	if err != nil {
+		return err
+	}
+
+	if len(generators) == 0 {
+		return fmt.Errorf("%w: %s", ErrRunNotFound, id)
+	}
+
+	return m.deleteBySelector(ctx, selector)
+}
+
+// runSelector - selector of generators of run with id; generators of all runs if id is empty.
+func (m *managerImpl) runSelector(id string) (string, error) {
+	var label string
+	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+		return "", fmt.Errorf("fail to define label: %w", err)
+	}
+
+	if id == "" {
+		return label + "," + runIDLabel, nil
+	}
+
+	if errs := validation.IsValidLabelValue(id); len(errs) > 0 {
+		return "", fmt.Errorf("%w: run id %q", ErrInvalidArgument, id)
+	}
+
+	return labels.Set{runIDLabel: id}.String() + "," + label, nil
+}
+
+// groupRuns groups generators by run; runs are ordered by creation time, the most recent first.
+func groupRuns(generators []model.LoadGenerator) []model.Run {
+	index := make(map[string]int)
+	runs := make([]model.Run, 0)
+
+	for _, generator := range generators {
+		id := generator.Labels[runIDLabel]
+		if id == "" {
+			continue
+		}
+
+		i, ok := index[id]
+		if !ok {
+			i = len(runs)
+			index[id] = i
+			runs = append(runs, model.Run{
+				ID:        id,
+				Name:      generator.Annotations[runNameAnnotation],
+				CreatedAt: generator.CreatedAt,
+			})
+		}
+
+		if generator.CreatedAt.Before(runs[i].CreatedAt) {
+			runs[i].CreatedAt = generator.CreatedAt
+		}
+
+		runs[i].Generators = append(runs[i].Generators, generator)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+
+	return runs
+}
@@ -0,0 +1,191 @@
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/multierr"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// spreadGroupLabel - group of generators spread over nodes or zones, one per creation request.
+	spreadGroupLabel = "lg-operator/spread-group"
+)
+
+var spreadTopologyKeys = map[model.Spread]string{
+	model.SpreadPerNode: coreV1.LabelHostname,
+	model.SpreadPerZone: coreV1.LabelTopologyZone,
+}
+
+// addScheduling sets node selector, tolerations and affinity of generator pod.
+// Spread generators repel pods of their spread group by the topology key of spread mode.
+func addScheduling(spec *coreV1.PodSpec, scheduling model.Scheduling, spreadGroup string) error {
+	if err := validateScheduling(scheduling); err != nil {
+		return err
+	}
+
+	spec.NodeSelector = scheduling.NodeSelector
+
+	for _, toleration := range scheduling.Tolerations {
+		built := coreV1.Toleration{
+			Key:      toleration.Key,
+			Operator: coreV1.TolerationOperator(toleration.Operator),
+			Value:    toleration.Value,
+			Effect:   coreV1.TaintEffect(toleration.Effect),
+		}
+
+		if toleration.TolerationSeconds != 0 {
+			seconds := toleration.TolerationSeconds
+			built.TolerationSeconds = &seconds
+		}
+
+		spec.Tolerations = append(spec.Tolerations, built)
+	}
+
+	if scheduling.Affinity != nil {
+		spec.Affinity = &coreV1.Affinity{NodeAffinity: buildNodeAffinity(*scheduling.Affinity)}
+	}
+
+	if scheduling.Spread == model.SpreadNone {
+		return nil
+	}
+
+	if spec.Affinity == nil {
+		spec.Affinity = &coreV1.Affinity{}
+	}
+
+	spec.Affinity.PodAntiAffinity = &coreV1.PodAntiAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: []coreV1.PodAffinityTerm{{
+			LabelSelector: &metaV1.LabelSelector{MatchLabels: map[string]string{spreadGroupLabel: spreadGroup}},
+			TopologyKey:   spreadTopologyKeys[scheduling.Spread],
+		}},
+	}
+
+	return nil
+}
+
+func buildNodeAffinity(affinity model.Affinity) *coreV1.NodeAffinity {
+	if len(affinity.Required) == 0 && len(affinity.Preferred) == 0 {
+		return nil
+	}
+
+	nodeAffinity := &coreV1.NodeAffinity{}
+
+	if len(affinity.Required) > 0 {
+		term := coreV1.NodeSelectorTerm{}
+		for _, requirement := range affinity.Required {
+			term.MatchExpressions = append(term.MatchExpressions, buildNodeSelectorRequirement(requirement))
+		}
+
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &coreV1.NodeSelector{
+			NodeSelectorTerms: []coreV1.NodeSelectorTerm{term},
+		}
+	}
+
+	for _, preferred := range affinity.Preferred {
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			coreV1.PreferredSchedulingTerm{
+				Weight: preferred.Weight,
+				Preference: coreV1.NodeSelectorTerm{
+					MatchExpressions: []coreV1.NodeSelectorRequirement{buildNodeSelectorRequirement(preferred.Requirement)},
+				},
+			})
+	}
+
+	return nodeAffinity
+}
+
+func buildNodeSelectorRequirement(requirement model.NodeSelectorRequirement) coreV1.NodeSelectorRequirement {
+	return coreV1.NodeSelectorRequirement{
+		Key:      requirement.Key,
+		Operator: coreV1.NodeSelectorOperator(requirement.Operator),
+		Values:   requirement.Values,
+	}
+}
+
+func validateScheduling(scheduling model.Scheduling) error {
+	var err error
+
+	for key, val := range scheduling.NodeSelector {
+		for _, msg := range validation.IsQualifiedName(key) {
+			err = multierr.Append(err, fmt.Errorf("node selector key %q: %s", key, msg))
+		}
+
+		for _, msg := range validation.IsValidLabelValue(val) {
+			err = multierr.Append(err, fmt.Errorf("node selector value %q: %s", val, msg))
+		}
+	}
+
+	for _, toleration := range scheduling.Tolerations {
+		switch coreV1.TolerationOperator(toleration.Operator) {
+		case "", coreV1.TolerationOpEqual:
+		case coreV1.TolerationOpExists:
+			if toleration.Value != "" {
+				err = multierr.Append(err, fmt.Errorf("toleration %q with operator Exists must not have value", toleration.Key))
+			}
+		default:
+			err = multierr.Append(err, fmt.Errorf("operator %q of toleration %q is not supported", toleration.Operator, toleration.Key))
+		}
+
+		switch coreV1.TaintEffect(toleration.Effect) {
+		case "", coreV1.TaintEffectNoSchedule, coreV1.TaintEffectPreferNoSchedule, coreV1.TaintEffectNoExecute:
+		default:
+			err = multierr.Append(err, fmt.Errorf("effect %q of toleration %q is not supported", toleration.Effect, toleration.Key))
+		}
+	}
+
+	if scheduling.Affinity != nil {
+		for _, requirement := range scheduling.Affinity.Required {
+			err = multierr.Append(err, validateNodeSelectorRequirement(requirement))
+		}
+
+		for _, preferred := range scheduling.Affinity.Preferred {
+			if preferred.Weight < 1 || preferred.Weight > 100 {
+				err = multierr.Append(err, fmt.Errorf("weight %d of preferred affinity is not in range 1-100", preferred.Weight))
+			}
+
+			err = multierr.Append(err, validateNodeSelectorRequirement(preferred.Requirement))
+		}
+	}
+
+	if _, ok := spreadTopologyKeys[scheduling.Spread]; !ok && scheduling.Spread != model.SpreadNone {
+		err = multierr.Append(err, fmt.Errorf("spread %q is not supported", scheduling.Spread))
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+
+	return nil
+}
+
+func validateNodeSelectorRequirement(requirement model.NodeSelectorRequirement) error {
+	var err error
+
+	for _, msg := range validation.IsQualifiedName(requirement.Key) {
+		err = multierr.Append(err, fmt.Errorf("affinity key %q: %s", requirement.Key, msg))
+	}
+
+	switch coreV1.NodeSelectorOperator(requirement.Operator) {
+	case coreV1.NodeSelectorOpIn, coreV1.NodeSelectorOpNotIn:
+		if len(requirement.Values) == 0 {
+			err = multierr.Append(err, fmt.Errorf("affinity %q with operator %s requires values", requirement.Key, requirement.Operator))
+		}
+	case coreV1.NodeSelectorOpExists, coreV1.NodeSelectorOpDoesNotExist:
+		if len(requirement.Values) > 0 {
+			err = multierr.Append(err, fmt.Errorf("affinity %q with operator %s must not have values", requirement.Key, requirement.Operator))
+		}
+	case coreV1.NodeSelectorOpGt, coreV1.NodeSelectorOpLt:
+		if len(requirement.Values) != 1 {
+			err = multierr.Append(err, fmt.Errorf("affinity %q with operator %s requires single value", requirement.Key, requirement.Operator))
+		}
+	default:
+		err = multierr.Append(err, fmt.Errorf("operator %q of affinity %q is not supported", requirement.Operator, requirement.Key))
+	}
+
+	return err
+}
@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAddScheduling_spread(t *testing.T) {
+	required := []model.NodeSelectorRequirement{{Key: "pool", Operator: "In", Values: []string{"load"}}}
+
+	antiAffinity := func(topologyKey string) *coreV1.PodAntiAffinity {
+		return &coreV1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []coreV1.PodAffinityTerm{{
+				LabelSelector: &metaV1.LabelSelector{MatchLabels: map[string]string{spreadGroupLabel: "group-1"}},
+				TopologyKey:   topologyKey,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		scheduling   model.Scheduling
+		want         *coreV1.PodAntiAffinity
+		nodeAffinity bool
+	}{
+		{name: "not spread"},
+		{name: "per node", scheduling: model.Scheduling{Spread: model.SpreadPerNode}, want: antiAffinity(coreV1.LabelHostname)},
+		{name: "per zone", scheduling: model.Scheduling{Spread: model.SpreadPerZone}, want: antiAffinity(coreV1.LabelTopologyZone)},
+		{
+			name:         "per node with node affinity",
+			scheduling:   model.Scheduling{Spread: model.SpreadPerNode, Affinity: &model.Affinity{Required: required}},
+			want:         antiAffinity(coreV1.LabelHostname),
+			nodeAffinity: true,
+		},
+		{
+			name:         "node affinity without spread",
+			scheduling:   model.Scheduling{Affinity: &model.Affinity{Required: required}},
+			nodeAffinity: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := coreV1.PodSpec{}
+
+			assert.NoError(t, addScheduling(&spec, tt.scheduling, "group-1"))
+
+			if tt.want == nil && !tt.nodeAffinity {
+				assert.Nil(t, spec.Affinity)
+				return
+			}
+
+			assert.Equal(t, tt.want, spec.Affinity.PodAntiAffinity)
+			assert.Equal(t, tt.nodeAffinity, spec.Affinity.NodeAffinity != nil)
+		})
+	}
+}
+
+func TestValidateScheduling(t *testing.T) {
+	tests := []struct {
+		name       string
+		scheduling model.Scheduling
+		wantErr    string
+	}{
+		{
+			name: "valid",
+			scheduling: model.Scheduling{
+				NodeSelector: map[string]string{"pool": "load"},
+				Tolerations:  []model.Toleration{{Key: "dedicated", Operator: "Exists", Effect: "NoSchedule"}},
+				Affinity: &model.Affinity{
+					Required:  []model.NodeSelectorRequirement{{Key: "pool", Operator: "In", Values: []string{"load"}}},
+					Preferred: []model.PreferredNodeSelectorRequirement{{Weight: 10, Requirement: model.NodeSelectorRequirement{Key: "ssd", Operator: "Exists"}}},
+				},
+				Spread: model.SpreadPerZone,
+			},
+		},
+		{
+			name:       "unsupported spread",
+			scheduling: model.Scheduling{Spread: "PER_RACK"},
+			wantErr:    `spread "PER_RACK" is not supported`,
+		},
+		{
+			name:       "invalid node selector",
+			scheduling: model.Scheduling{NodeSelector: map[string]string{"pool": "load pool"}},
+			wantErr:    `node selector value "load pool"`,
+		},
+		{
+			name:       "toleration Exists with value",
+			scheduling: model.Scheduling{Tolerations: []model.Toleration{{Key: "dedicated", Operator: "Exists", Value: "lg"}}},
+			wantErr:    `toleration "dedicated" with operator Exists must not have value`,
+		},
+		{
+			name:       "unsupported toleration effect",
+			scheduling: model.Scheduling{Tolerations: []model.Toleration{{Key: "dedicated", Effect: "NoRun"}}},
+			wantErr:    `effect "NoRun" of toleration "dedicated" is not supported`,
+		},
+		{
+			name: "affinity In without values",
+			scheduling: model.Scheduling{Affinity: &model.Affinity{
+				Required: []model.NodeSelectorRequirement{{Key: "pool", Operator: "In"}},
+			}},
+			wantErr: `affinity "pool" with operator In requires values`,
+		},
+		{
+			name: "preferred weight out of range",
+			scheduling: model.Scheduling{Affinity: &model.Affinity{
+				Preferred: []model.PreferredNodeSelectorRequirement{{Weight: 0, Requirement: model.NodeSelectorRequirement{Key: "ssd", Operator: "Exists"}}},
+			}},
+			wantErr: "weight 0 of preferred affinity is not in range 1-100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScheduling(tt.scheduling)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
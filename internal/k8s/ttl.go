@@ -0,0 +1,37 @@
+package k8s
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	ttlAnnotation = "lg-operator/ttl"
+)
+
+// activeDeadlineSeconds - k8s fails pod of generator after its ttl; no deadline if ttl is zero.
+// Failed pod loses its artifacts, so with artifacts sidecar the deadline is postponed by grace period
+// and the outdated cleaner collects artifacts and deletes the generator at its ttl.
+func activeDeadlineSeconds(ttl time.Duration, artifacts ArtifactsConfig) *int64 {
+	if ttl <= 0 {
+		return nil
+	}
+
+	if len(artifacts.Paths) > 0 {
+		ttl += artifacts.DeadlineGrace
+	}
+
+	seconds := int64(math.Ceil(ttl.Seconds()))
+
+	return &seconds
+}
+
+// generatorTTL - ttl from annotations of generator; zero if it is not set or invalid.
+func generatorTTL(annotations map[string]string) time.Duration {
+	ttl, err := time.ParseDuration(annotations[ttlAnnotation])
+	if err != nil {
+		return 0
+	}
+
+	return ttl
+}
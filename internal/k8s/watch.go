@@ -0,0 +1,219 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+var (
+	generatorEventTypes = map[watch.EventType]model.GeneratorEventType{
+		watch.Added:    model.GeneratorAdded,
+		watch.Modified: model.GeneratorModified,
+		watch.Deleted:  model.GeneratorDeleted,
+	}
+)
+
+// Watch changes of load generators until ctx is done or handle returns an error.
+// If resourceVersion is empty, existing generators are passed to handle as added first.
+// Watch is resumed from the last observed resource version when k8s closes it.
+func (m *managerImpl) Watch(ctx context.Context, resourceVersion string, handle func(model.GeneratorEvent) error) error {
+	var label string
+	if err := m.config.UnmarshalKey(lgLabelKey, &label); err != nil {
+		return fmt.Errorf("fail to define label: %w", err)
+	}
+
+	if resourceVersion == "" {
+		generators, listVersion, err := m.listGenerators(ctx, label)
+		if err != nil {
+			return err
+		}
+
+		for _, generator := range generators {
+			if err = handle(model.GeneratorEvent{
+				Type:            model.GeneratorAdded,
+				Generator:       generator,
+				ResourceVersion: listVersion,
+			}); err != nil {
+				return err
+			}
+		}
+
+		resourceVersion = listVersion
+	}
+
+	for {
+		var err error
+		if resourceVersion, err = m.watchPods(ctx, label, resourceVersion, handle); err != nil {
+			return err
+		}
+
+		m.logger.Debug("generators watch closed, resume", zap.String("resource_version", resourceVersion))
+	}
+}
+
+// watchPods handles events of generator pods until watch is closed; returns the last observed resource version.
+func (m *managerImpl) watchPods(
+	ctx context.Context,
+	label, resourceVersion string,
+	handle func(model.GeneratorEvent) error) (string, error) {
+	watcher, err := m.client.Get().
+		CoreV1().
+		Pods(m.namespace).
+		Watch(ctx, metaV1.ListOptions{
+			LabelSelector:       label,
+			ResourceVersion:     resourceVersion,
+			AllowWatchBookmarks: true,
+		})
+	if err != nil {
+		return "", watchError(err, resourceVersion)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+
+			if event.Type == watch.Error {
+				return "", watchError(apiErrors.FromObject(event.Object), resourceVersion)
+			}
+
+			pod, ok := event.Object.(*coreV1.Pod)
+			if !ok {
+				continue
+			}
+
+			resourceVersion = pod.ResourceVersion
+
+			eventType, ok := generatorEventTypes[event.Type]
+			if !ok {
+				// bookmark only moves resource version forward
+				continue
+			}
+
+			if err = handle(model.GeneratorEvent{
+				Type:            eventType,
+				Generator:       m.watchedGenerator(ctx, *pod, eventType),
+				ResourceVersion: resourceVersion,
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+// watchedGenerator joins watched pod with its service; service of deleted generator is not requested.
+func (m *managerImpl) watchedGenerator(ctx context.Context, pod coreV1.Pod, eventType model.GeneratorEventType) model.LoadGenerator {
+	if eventType == model.GeneratorDeleted {
+		return makeLoadGenerator(pod, coreV1.Service{})
+	}
+
+	service, err := m.client.Get().
+		CoreV1().
+		Services(m.namespace).
+		Get(ctx, pod.Name, metaV1.GetOptions{})
+	if err != nil {
+		m.logger.Warn("fail to get service of watched generator", zap.String("generator_name", pod.Name), zap.Error(err))
+		return makeLoadGenerator(pod, coreV1.Service{})
+	}
+
+	return makeLoadGenerator(pod, *service)
+}
+
+// watchParts - watch parts concurrently, e.g. namespaces or clusters, while handle is called sequentially.
+// Resource versions of parts are independent, so resource version of event joins the last versions of all parts
+// as part, assign and version separated by sep, and watching is resumed from it in each part.
+func watchParts(
+	ctx context.Context,
+	parts []string,
+	sep, assign, resourceVersion string,
+	handle func(model.GeneratorEvent) error,
+	watchPart func(ctx context.Context, part, resourceVersion string, handle func(model.GeneratorEvent) error) error) error {
+	versions, err := parseVersions(resourceVersion, sep, assign)
+	if err != nil {
+		return err
+	}
+
+	// versions of parts are read before watching, since events of started parts update them
+	froms := make(map[string]string, len(versions))
+	for part, version := range versions {
+		froms[part] = version
+	}
+
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, part := range parts {
+		part, from := part, froms[part]
+
+		g.Go(func() error {
+			return watchPart(gctx, part, from, func(event model.GeneratorEvent) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if event.ResourceVersion != "" {
+					versions[part] = event.ResourceVersion
+				}
+
+				event.ResourceVersion = formatVersions(versions, sep, assign)
+
+				return handle(event)
+			})
+		})
+	}
+
+	return g.Wait()
+}
+
+// parseVersions - resource versions of parts by name; empty if watching starts from the current state.
+func parseVersions(resourceVersion, sep, assign string) (map[string]string, error) {
+	versions := make(map[string]string)
+	if resourceVersion == "" {
+		return versions, nil
+	}
+
+	for _, pair := range strings.Split(resourceVersion, sep) {
+		part, version, found := strings.Cut(pair, assign)
+		if !found || part == "" || version == "" {
+			return nil, fmt.Errorf("%w: resource version %q", ErrInvalidArgument, resourceVersion)
+		}
+
+		versions[part] = version
+	}
+
+	return versions, nil
+}
+
+func formatVersions(versions map[string]string, sep, assign string) string {
+	pairs := make([]string, 0, len(versions))
+	for part, version := range versions {
+		pairs = append(pairs, part+assign+version)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, sep)
+}
+
+func watchError(err error, resourceVersion string) error {
+	if apiErrors.IsResourceExpired(err) || apiErrors.IsGone(err) {
+		return fmt.Errorf("%w: %s", ErrResourceVersionExpired, resourceVersion)
+	}
+
+	return fmt.Errorf("fail to watch pods: %w", err)
+}
@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchParts(t *testing.T) {
+	ctx := context.Background()
+	errStop := errors.New("stop")
+
+	// every part sends one event of the next version after the one it is resumed from
+	var (
+		mu    sync.Mutex
+		froms = make(map[string]string)
+	)
+
+	watchPart := func(ctx context.Context, part, from string, handle func(model.GeneratorEvent) error) error {
+		mu.Lock()
+		froms[part] = from
+		mu.Unlock()
+
+		next := map[string]string{"": "1", "1": "2", "7": "8"}[from]
+		if err := handle(model.GeneratorEvent{Generator: model.LoadGenerator{Name: part}, ResourceVersion: next}); err != nil {
+			return err
+		}
+
+		// the part is watched until another one fails
+		<-ctx.Done()
+
+		return nil
+	}
+
+	var versions []string
+	handle := func(event model.GeneratorEvent) error {
+		versions = append(versions, event.ResourceVersion)
+		if len(versions) == 2 {
+			return errStop
+		}
+
+		return nil
+	}
+
+	err := watchParts(ctx, []string{"a", "b"}, ";", ":", "a:1;b:7", handle, watchPart)
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, map[string]string{"a": "1", "b": "7"}, froms)
+	// version of event keeps the last versions of all parts
+	assert.Equal(t, "a:2;b:8", versions[1])
+
+	err = watchParts(ctx, []string{"a", "b"}, ";", ":", "a=1,b=7", handle, watchPart)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestParseVersions(t *testing.T) {
+	versions, err := parseVersions("eu=team-a:1042;team-b:977,us=977", ",", "=")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"eu": "team-a:1042;team-b:977", "us": "977"}, versions)
+
+	// resource version of namespaces is nested into the one of clusters
+	namespaces, err := parseVersions(versions["eu"], ";", ":")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team-a": "1042", "team-b": "977"}, namespaces)
+	assert.Equal(t, "team-a:1042;team-b:977", formatVersions(namespaces, ";", ":"))
+
+	versions, err = parseVersions("", ",", "=")
+	assert.NoError(t, err)
+	assert.Empty(t, versions)
+}
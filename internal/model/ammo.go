@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Ammo - file uploaded to the operator storage for mounting into load-generators.
+/*
+  - ID - identifier of ammo referenced by creation parameters;
+  - Name - original name of file;
+  - Size - size of file in bytes;
+  - SHA256 - hex-encoded sha256 checksum of file;
+  - CreatedAt - time of upload;
+  - UsedAt - last time the ammo was referenced by creation of load-generator.
+*/
+type Ammo struct {
+	ID        string
+	Name      string
+	Size      int64
+	SHA256    string
+	CreatedAt time.Time
+	UsedAt    time.Time
+}
+
+// AmmoMount - ammo mounted into load-generator container as a read-only file at MountPath.
+type AmmoMount struct {
+	ID        string
+	MountPath string
+}
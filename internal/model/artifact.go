@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Artifact - archive of load-generator files collected before its deletion.
+/*
+  - Generator - name of load-generator the archive is collected from;
+  - Name - name of archive unique among archives of the load-generator;
+  - Size - size of archive in bytes;
+  - CreatedAt - time of collection.
+*/
+type Artifact struct {
+	Generator string
+	Name      string
+	Size      int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,8 @@
+package model
+
+// CreationResult - result of load-generator creation for one of request parameters:
+// either created generator or the error of its creation.
+type CreationResult struct {
+	Generator *LoadGenerator
+	Err       error
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+)
+
+// GeneratorDetails - load-generator with diagnostics of its pod.
+type GeneratorDetails struct {
+	Generator   LoadGenerator
+	Diagnostics GeneratorDiagnostics
+}
+
+// GeneratorDiagnostics - state of load-generator pod and its container.
+/*
+  - NodeName - name of k8s node where pod is scheduled;
+  - PodIP - IP address allocated to the pod;
+  - ContainerState - state of generator container: waiting, running or terminated;
+  - WaitingReason - reason why container is not yet running (e.g. ImagePullBackOff);
+  - RestartCount - the number of times the container has been restarted;
+  - StartedAt, FinishedAt - time when container started and finished;
+  - ExitCode, TerminationReason, TerminationMessage - details of container termination;
+  - Events - recent k8s events of generator pod, service and ingress.
+*/
+type GeneratorDiagnostics struct {
+	NodeName           string
+	PodIP              string
+	ContainerState     ContainerState
+	WaitingReason      string
+	RestartCount       int32
+	StartedAt          time.Time
+	FinishedAt         time.Time
+	ExitCode           int32
+	TerminationReason  string
+	TerminationMessage string
+	Events             []Event
+}
+
+// ContainerState - state of load-generator container.
+type ContainerState string
+
+// Container states.
+const (
+	ContainerStateUnknown    ContainerState = ""
+	ContainerStateWaiting    ContainerState = "waiting"
+	ContainerStateRunning    ContainerState = "running"
+	ContainerStateTerminated ContainerState = "terminated"
+)
+
+// Event - k8s event related to one of load-generator entities.
+type Event struct {
+	ObjectKind    string
+	Type          string
+	Reason        string
+	Message       string
+	Count         int32
+	LastTimestamp time.Time
+}
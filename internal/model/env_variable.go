@@ -1,7 +1,34 @@
 package model
 
 // EnvVar - environment variable model.
+/*
+  - Name - name of variable;
+  - Value - literal value; ignored if SecretKeyRef or ConfigMapKeyRef is set;
+  - SecretKeyRef, ConfigMapKeyRef - value from key of Secret or ConfigMap; at most one of them is set.
+*/
 type EnvVar struct {
-	Name  string
-	Value string
+	Name            string
+	Value           string
+	SecretKeyRef    *KeyRef `mapstructure:"secret_key_ref"`
+	ConfigMapKeyRef *KeyRef `mapstructure:"config_map_key_ref"`
+}
+
+// KeyRef - key of Secret or ConfigMap; variable is not set if Optional and the key does not exist.
+type KeyRef struct {
+	Name     string
+	Key      string
+	Optional bool
+}
+
+// EnvFrom - all keys of Secret or ConfigMap imported as environment variables; exactly one source is set.
+/*
+  - Prefix - prefix of names of imported variables;
+  - SecretName, ConfigMapName - name of Secret or ConfigMap;
+  - Optional - variables are not set if the object does not exist.
+*/
+type EnvFrom struct {
+	Prefix        string
+	SecretName    string `mapstructure:"secret_name"`
+	ConfigMapName string `mapstructure:"config_map_name"`
+	Optional      bool
 }
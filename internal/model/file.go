@@ -0,0 +1,13 @@
+package model
+
+// File - file mounted into load-generator container.
+/*
+  - Name - name of file;
+  - MountPath - absolute path of directory the file is mounted to;
+  - Content - content of file.
+*/
+type File struct {
+	Name      string
+	MountPath string
+	Content   string
+}
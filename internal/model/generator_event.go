@@ -0,0 +1,23 @@
+package model
+
+// GeneratorEventType - type of load-generator change.
+type GeneratorEventType string
+
+// Types of load-generator changes.
+const (
+	GeneratorAdded    GeneratorEventType = "ADDED"
+	GeneratorModified GeneratorEventType = "MODIFIED"
+	GeneratorDeleted  GeneratorEventType = "DELETED"
+)
+
+// GeneratorEvent - change of load-generator observed by k8s watch.
+/*
+  - Type - type of change;
+  - Generator - state of load-generator after the change (last known state for deleted one);
+  - ResourceVersion - k8s resource version, which can be used to resume watching.
+*/
+type GeneratorEvent struct {
+	Type            GeneratorEventType
+	Generator       LoadGenerator
+	ResourceVersion string
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// GeneratorFilter - conditions of load-generators listing.
+/*
+  - Cluster - name of k8s cluster of load-generators; all clusters if empty;
+  - Namespace - k8s namespace of load-generators; all managed namespaces if empty;
+  - LabelSelector - k8s label selector, e.g. "team=perf,env!=prod";
+  - Statuses - k8s statuses of generator pods; any status if empty;
+  - Image - container image with or without tag; any image if empty;
+  - CreatedAfter, CreatedBefore - range of creation time; not limited if zero.
+*/
+type GeneratorFilter struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	Statuses      []coreV1.PodPhase
+	Image         string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// Match - generator satisfies all conditions of filter except label selector, which is applied by k8s.
+func (f GeneratorFilter) Match(generator LoadGenerator) bool {
+	if len(f.Statuses) > 0 && !containsPhase(f.Statuses, generator.Status) {
+		return false
+	}
+
+	if f.Image != "" && !matchImage(f.Image, generator.Image) {
+		return false
+	}
+
+	if !f.CreatedAfter.IsZero() && generator.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+
+	if !f.CreatedBefore.IsZero() && !generator.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+func containsPhase(phases []coreV1.PodPhase, phase coreV1.PodPhase) bool {
+	for _, p := range phases {
+		if p == phase {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchImage - image without tag or digest matches all its tags and digests.
+func matchImage(filter, image string) bool {
+	return image == filter ||
+		strings.HasPrefix(image, filter+":") ||
+		strings.HasPrefix(image, filter+"@")
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func TestGeneratorFilter_Match(t *testing.T) {
+	createdAt := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	generator := LoadGenerator{
+		Name:      "generator",
+		Status:    coreV1.PodRunning,
+		Image:     "registry.local/tank:1.2",
+		CreatedAt: createdAt,
+	}
+
+	tests := []struct {
+		name   string
+		filter GeneratorFilter
+		match  bool
+	}{
+		{name: "empty", filter: GeneratorFilter{}, match: true},
+		{name: "status", filter: GeneratorFilter{Statuses: []coreV1.PodPhase{coreV1.PodPending, coreV1.PodRunning}}, match: true},
+		{name: "other status", filter: GeneratorFilter{Statuses: []coreV1.PodPhase{coreV1.PodPending}}, match: false},
+		{name: "image", filter: GeneratorFilter{Image: "registry.local/tank:1.2"}, match: true},
+		{name: "image without tag", filter: GeneratorFilter{Image: "registry.local/tank"}, match: true},
+		{name: "other image", filter: GeneratorFilter{Image: "registry.local/tan"}, match: false},
+		{name: "created after", filter: GeneratorFilter{CreatedAfter: createdAt}, match: true},
+		{name: "created later", filter: GeneratorFilter{CreatedAfter: createdAt.Add(time.Second)}, match: false},
+		{name: "created before", filter: GeneratorFilter{CreatedBefore: createdAt.Add(time.Second)}, match: true},
+		{name: "created earlier", filter: GeneratorFilter{CreatedBefore: createdAt}, match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, tt.filter.Match(generator))
+		})
+	}
+}
@@ -9,16 +9,40 @@ import (
 // LoadGenerator model.
 /*
   - Name - name of load-generator pod deployed in k8s;
+  - Cluster - name of k8s cluster of load-generator; empty if the operator manages a single cluster;
+  - Namespace - k8s namespace of load-generator;
   - ClusterIP - ClusterIP of deployed load-generator service; available only within the k8s cluster;
   - ExternalIP - ExternalIP of deployed load-generator service; accessible from outside the k8s cluster;
-  - Port - port of load-generator service;
-  - Status - k8s status of load-generator pod.
+  - Port - main port of load-generator service, the first of Ports;
+  - Ports - all ports of load-generator container and service;
+  - Status - k8s status of load-generator pod;
+  - Ready - load-generator container passes its readiness probe;
+  - Labels, Annotations - metadata of load-generator pod;
+  - Image - container image of load-generator;
+  - Resources - compute resources of load-generator pod, including artifacts sidecar;
+  - TTL - lifetime of load-generator requested on creation; zero if global lifetime is applied;
+  - LeaseExpiresAt - time after which load-generator is deleted unless its lease is renewed; zero if it has no lease;
+  - AmmoIDs - ids of ammo mounted into load-generator;
+  - RequestHash - hash of creation request of load-generator created with idempotency key; empty otherwise;
+  - CreatedAt - creation time of load-generator pod.
 */
 type LoadGenerator struct {
-	Name       string
-	ClusterIP  string
-	ExternalIP string
-	Port       int32
-	Status     coreV1.PodPhase
-	CreatedAt  time.Time
+	Name           string
+	Cluster        string
+	Namespace      string
+	ClusterIP      string
+	ExternalIP     string
+	Port           int32
+	Ports          []Port
+	Status         coreV1.PodPhase
+	Ready          bool
+	Labels         map[string]string
+	Annotations    map[string]string
+	Image          string
+	Resources      Resources
+	TTL            time.Duration
+	LeaseExpiresAt time.Time
+	AmmoIDs        []string
+	RequestHash    string
+	CreatedAt      time.Time
 }
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// LogOptions - options of load-generator logs reading.
+/*
+  - Follow - keep streaming new lines until the generator stops;
+  - TailLines - the number of lines from the end of the logs to read; all lines if zero;
+  - SinceTime - read only lines written after this time; all lines if zero;
+  - Timestamps - prefix every line with its timestamp.
+*/
+type LogOptions struct {
+	Follow     bool
+	TailLines  int64
+	SinceTime  time.Time
+	Timestamps bool
+}
@@ -0,0 +1,32 @@
+package model
+
+// ManifestFormat - format of rendered k8s entities.
+type ManifestFormat string
+
+// Formats of manifests.
+const (
+	ManifestYAML ManifestFormat = "yaml"
+	ManifestJSON ManifestFormat = "json"
+)
+
+// RenderOptions - options of rendering load-generator entities.
+/*
+  - Format - format of manifests;
+  - ServerDryRun - send entities to k8s API in dry-run mode to run admission and get defaults set by k8s.
+*/
+type RenderOptions struct {
+	Format       ManifestFormat
+	ServerDryRun bool
+}
+
+// RenderedGenerator - k8s entities of load-generator rendered without creation.
+type RenderedGenerator struct {
+	Name      string
+	Manifests []Manifest
+}
+
+// Manifest - k8s entity of kind Pod, Service or Ingress.
+type Manifest struct {
+	Kind    string
+	Content string
+}
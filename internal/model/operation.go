@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+)
+
+// OperationStatus - status of long-running operation.
+type OperationStatus string
+
+// Statuses of long-running operation.
+const (
+	OperationRunning    OperationStatus = "RUNNING"
+	OperationCancelling OperationStatus = "CANCELLING"
+	OperationSucceeded  OperationStatus = "SUCCEEDED"
+	OperationFailed     OperationStatus = "FAILED"
+	OperationCancelled  OperationStatus = "CANCELLED"
+)
+
+// Done - operation is finished and its status will not change anymore.
+func (s OperationStatus) Done() bool {
+	return s == OperationSucceeded || s == OperationFailed || s == OperationCancelled
+}
+
+// Operation - long-running creation of load-generators.
+/*
+  - ID - unique identifier of operation;
+  - Status - status of the whole operation;
+  - Error - reason of operation failure;
+  - Generators - progress of every generator in order of creation parameters.
+*/
+type Operation struct {
+	ID         string
+	Status     OperationStatus
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Generators []GeneratorProgress
+}
+
+// GeneratorStage - stage of load-generator creation.
+type GeneratorStage string
+
+// Stages of load-generator creation.
+const (
+	StagePending      GeneratorStage = "PENDING"
+	StageScheduled    GeneratorStage = "SCHEDULED"
+	StagePullingImage GeneratorStage = "PULLING_IMAGE"
+	StageStarting     GeneratorStage = "STARTING"
+	StageRunning      GeneratorStage = "RUNNING"
+	StageFailed       GeneratorStage = "FAILED"
+	StageRolledBack   GeneratorStage = "ROLLED_BACK"
+)
+
+// GeneratorProgress - progress of load-generator creation.
+/*
+  - Name - name of generator; empty until its k8s entities are created;
+  - Stage - current stage of creation;
+  - Message - details of the stage, e.g. reason of waiting or error;
+  - Generator - parameters of running generator.
+*/
+type GeneratorProgress struct {
+	Name      string
+	Stage     GeneratorStage
+	Message   string
+	Generator *LoadGenerator
+}
@@ -0,0 +1,21 @@
+package model
+
+// PageRequest - page of list.
+/*
+  - Size - maximum number of items in page;
+  - Token - token of page returned with previous page; the first page if empty.
+*/
+type PageRequest struct {
+	Size  int64
+	Token string
+}
+
+// GeneratorsPage - page of load-generators list.
+/*
+  - Generators - load-generators of page; there are fewer of them than requested only in the last page, which may be empty;
+  - NextPageToken - token of the next page; empty for the last page.
+*/
+type GeneratorsPage struct {
+	Generators    []LoadGenerator
+	NextPageToken string
+}
@@ -0,0 +1,13 @@
+package model
+
+// Port - named port of load-generator container exposed by its service.
+/*
+  - Name - name of port, IANA_SVC_NAME;
+  - Port - number of port in container and service;
+  - Protocol - TCP, UDP or SCTP; TCP if empty.
+*/
+type Port struct {
+	Name     string
+	Port     int32
+	Protocol string
+}
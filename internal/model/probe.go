@@ -0,0 +1,32 @@
+package model
+
+// Probe - readiness probe of load-generator container; exactly one of HTTPGet, TCPSocket and Exec is set.
+/*
+  - InitialDelaySeconds, PeriodSeconds, TimeoutSeconds, FailureThreshold - parameters of probe; defaults of k8s if zero.
+*/
+type Probe struct {
+	HTTPGet             *HTTPGetProbe   `mapstructure:"http_get"`
+	TCPSocket           *TCPSocketProbe `mapstructure:"tcp_socket"`
+	Exec                *ExecProbe
+	InitialDelaySeconds int32 `mapstructure:"initial_delay_seconds"`
+	PeriodSeconds       int32 `mapstructure:"period_seconds"`
+	TimeoutSeconds      int32 `mapstructure:"timeout_seconds"`
+	FailureThreshold    int32 `mapstructure:"failure_threshold"`
+}
+
+// HTTPGetProbe - generator is ready if GET request of Path succeeds; Port is name or number of port, main port if empty.
+type HTTPGetProbe struct {
+	Path   string
+	Port   string
+	Scheme string
+}
+
+// TCPSocketProbe - generator is ready if Port accepts connections; Port is name or number of port, main port if empty.
+type TCPSocketProbe struct {
+	Port string
+}
+
+// ExecProbe - generator is ready if Command exits with zero in its container.
+type ExecProbe struct {
+	Command []string
+}
@@ -0,0 +1,27 @@
+package model
+
+// Quota - limits of generators of a team defined in config; a limit is not applied if it is zero or empty.
+/*
+  - MaxGenerators - maximum number of pending and running generators;
+  - CPU, Memory - maximum total cpu and memory requested by pending and running generators.
+*/
+type Quota struct {
+	MaxGenerators int `mapstructure:"max_generators"`
+	CPU           string
+	Memory        string
+}
+
+// QuotaUsage - quota of a team and resources requested by its generators.
+/*
+  - Team - value of owner label of generators;
+  - Quota - quota of the team; nil if the team is not limited;
+  - Generators - number of pending and running generators, including the ones being created;
+  - CPU, Memory - total cpu and memory requested by the generators.
+*/
+type QuotaUsage struct {
+	Team       string
+	Quota      *Quota
+	Generators int
+	CPU        string
+	Memory     string
+}
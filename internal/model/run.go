@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// Run - group of load-generators started together for one load test.
+/*
+  - ID - unique identifier of run;
+  - Name - optional human-readable name of run;
+  - CreatedAt - creation time of the first generator of run;
+  - Generators - existing generators of run.
+*/
+type Run struct {
+	ID         string
+	Name       string
+	CreatedAt  time.Time
+	Generators []LoadGenerator
+}
+
+// RunStatus - aggregate status of run generators.
+type RunStatus string
+
+// Statuses of run.
+const (
+	// RunPending - some generators are not running yet and none has failed.
+	RunPending RunStatus = "PENDING"
+	// RunRunning - all generators are running or completed, at least one is running.
+	RunRunning RunStatus = "RUNNING"
+	// RunCompleted - all generators completed successfully.
+	RunCompleted RunStatus = "COMPLETED"
+	// RunPartiallyFailed - some generators failed, the others are still alive or completed.
+	RunPartiallyFailed RunStatus = "PARTIALLY_FAILED"
+	// RunFailed - all generators failed.
+	RunFailed RunStatus = "FAILED"
+)
+
+// Status - aggregate status derived from statuses of run generators.
+func (r Run) Status() RunStatus {
+	counts := make(map[coreV1.PodPhase]int, 5)
+	for _, generator := range r.Generators {
+		counts[generator.Status]++
+	}
+
+	total := len(r.Generators)
+
+	switch {
+	case counts[coreV1.PodFailed] == total:
+		return RunFailed
+	case counts[coreV1.PodFailed] > 0:
+		return RunPartiallyFailed
+	case counts[coreV1.PodSucceeded] == total:
+		return RunCompleted
+	case counts[coreV1.PodRunning]+counts[coreV1.PodSucceeded] == total:
+		return RunRunning
+	default:
+		return RunPending
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func TestRun_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []coreV1.PodPhase
+		status   RunStatus
+	}{
+		{name: "pending", statuses: []coreV1.PodPhase{coreV1.PodRunning, coreV1.PodPending}, status: RunPending},
+		{name: "running", statuses: []coreV1.PodPhase{coreV1.PodRunning, coreV1.PodSucceeded}, status: RunRunning},
+		{name: "completed", statuses: []coreV1.PodPhase{coreV1.PodSucceeded, coreV1.PodSucceeded}, status: RunCompleted},
+		{name: "partially failed", statuses: []coreV1.PodPhase{coreV1.PodRunning, coreV1.PodFailed}, status: RunPartiallyFailed},
+		{name: "failed", statuses: []coreV1.PodPhase{coreV1.PodFailed, coreV1.PodFailed}, status: RunFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var run Run
+			for _, st := range tt.statuses {
+				run.Generators = append(run.Generators, LoadGenerator{Status: st})
+			}
+
+			assert.Equal(t, tt.status, run.Status())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+// Spread - spreading of generators of the same creation request.
+type Spread string
+
+// Spread modes of generators.
+const (
+	SpreadNone    Spread = ""
+	SpreadPerNode Spread = "PER_NODE"
+	SpreadPerZone Spread = "PER_ZONE"
+)
+
+// Scheduling - constraints of nodes load-generators run on.
+/*
+  - NodeSelector - labels of nodes;
+  - Tolerations - taints of nodes tolerated by generators;
+  - Affinity - node affinity of generators; optional;
+  - Spread - anti-affinity of generators of the same creation request.
+*/
+type Scheduling struct {
+	NodeSelector map[string]string `mapstructure:"node_selector"`
+	Tolerations  []Toleration
+	Affinity     *Affinity
+	Spread       Spread
+}
+
+// Toleration - taint of nodes tolerated by generator; TolerationSeconds is not set if zero.
+type Toleration struct {
+	Key               string
+	Operator          string
+	Value             string
+	Effect            string
+	TolerationSeconds int64 `mapstructure:"toleration_seconds"`
+}
+
+// Affinity - node affinity; all requirements of a list must be met by a node.
+type Affinity struct {
+	Required  []NodeSelectorRequirement
+	Preferred []PreferredNodeSelectorRequirement
+}
+
+// NodeSelectorRequirement - requirement of node label.
+type NodeSelectorRequirement struct {
+	Key      string
+	Operator string
+	Values   []string
+}
+
+// PreferredNodeSelectorRequirement - requirement of node label preferred with Weight in range 1-100.
+type PreferredNodeSelectorRequirement struct {
+	Weight      int32
+	Requirement NodeSelectorRequirement
+}
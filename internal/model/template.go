@@ -0,0 +1,24 @@
+package model
+
+// Template - named preset of load-generator parameters defined in config.
+/*
+  - Name - name of template referenced by creation parameters;
+  - Description - human-readable description of template;
+  - Image, Commands, Args, WorkingDir, Ports, ReadinessProbe, Envs, Resources, ExposeExternalIP, Labels, Annotations - default parameters of generator,
+    which are overridden by creation parameters.
+*/
+type Template struct {
+	Name             string
+	Description      string
+	Image            string
+	Commands         []string
+	Args             []string
+	WorkingDir       string `mapstructure:"working_dir"`
+	Ports            []Port
+	ReadinessProbe   *Probe `mapstructure:"readiness_probe"`
+	Envs             []EnvVar
+	Resources        Resources
+	ExposeExternalIP bool `mapstructure:"expose_external_ip"`
+	Labels           map[string]string
+	Annotations      map[string]string
+}
@@ -0,0 +1,191 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spirt-t/lg-operator/internal/model"
+)
+
+var (
+	// ErrNotFound - operation with requested id does not exist or has expired.
+	ErrNotFound = errors.New("operation not found")
+	// ErrFinished - operation is already finished and can not be cancelled.
+	ErrFinished = errors.New("operation is already finished")
+)
+
+// ProgressFunc - report progress of i-th generator of operation.
+type ProgressFunc func(i int, progress model.GeneratorProgress)
+
+// RunFunc - body of operation; ctx is cancelled when operation is cancelled.
+type RunFunc func(ctx context.Context, progress ProgressFunc) error
+
+// Storage - in-memory storage of long-running operations.
+// Finished operations are kept for retention period.
+type Storage struct {
+	mu         sync.Mutex
+	operations map[string]*entry
+	retention  time.Duration
+}
+
+type entry struct {
+	operation model.Operation
+	cancel    context.CancelFunc
+}
+
+// NewStorage - constructor for Storage.
+func NewStorage(retention time.Duration) *Storage {
+	return &Storage{
+		operations: make(map[string]*entry),
+		retention:  retention,
+	}
+}
+
+// Start new operation for the given number of generators and run it in background.
+func (s *Storage) Start(generators int, run RunFunc) model.Operation {
+	ctx, cancel := context.WithCancel(context.Background())
+	now := time.Now().UTC()
+
+	op := model.Operation{
+		ID:         uuid.New().String(),
+		Status:     model.OperationRunning,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Generators: make([]model.GeneratorProgress, generators),
+	}
+	for i := range op.Generators {
+		op.Generators[i].Stage = model.StagePending
+	}
+
+	s.mu.Lock()
+	s.removeExpired(now)
+	s.operations[op.ID] = &entry{operation: op, cancel: cancel}
+	res := copyOperation(op)
+	s.mu.Unlock()
+
+	go func() {
+		defer cancel()
+
+		err := run(ctx, func(i int, progress model.GeneratorProgress) {
+			s.progress(op.ID, i, progress)
+		})
+		s.finish(op.ID, ctx.Err() != nil, err)
+	}()
+
+	return res
+}
+
+func (s *Storage) progress(id string, i int, progress model.GeneratorProgress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.operations[id]
+	if !ok || i < 0 || i >= len(e.operation.Generators) {
+		return
+	}
+
+	// name is known only since k8s entities creation, keep it for the next stages
+	if progress.Name == "" {
+		progress.Name = e.operation.Generators[i].Name
+	}
+
+	e.operation.Generators[i] = progress
+	e.operation.UpdatedAt = time.Now().UTC()
+}
+
+func (s *Storage) finish(id string, cancelled bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.operations[id]
+	if !ok {
+		return
+	}
+
+	switch {
+	case cancelled:
+		e.operation.Status = model.OperationCancelled
+	case err != nil:
+		e.operation.Status = model.OperationFailed
+		e.operation.Error = err.Error()
+	default:
+		e.operation.Status = model.OperationSucceeded
+	}
+
+	e.operation.UpdatedAt = time.Now().UTC()
+}
+
+// Get operation by id.
+func (s *Storage) Get(id string) (model.Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(time.Now().UTC())
+
+	e, ok := s.operations[id]
+	if !ok {
+		return model.Operation{}, ErrNotFound
+	}
+
+	return copyOperation(e.operation), nil
+}
+
+// List of operations; the most recent first.
+func (s *Storage) List() []model.Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(time.Now().UTC())
+
+	list := make([]model.Operation, 0, len(s.operations))
+	for _, e := range s.operations {
+		list = append(list, copyOperation(e.operation))
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.After(list[j].CreatedAt)
+	})
+
+	return list
+}
+
+// Cancel running operation. Operation becomes cancelled after its body has returned.
+func (s *Storage) Cancel(id string) (model.Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.operations[id]
+	if !ok {
+		return model.Operation{}, ErrNotFound
+	}
+
+	if e.operation.Status.Done() {
+		return copyOperation(e.operation), ErrFinished
+	}
+
+	e.cancel()
+	e.operation.Status = model.OperationCancelling
+	e.operation.UpdatedAt = time.Now().UTC()
+
+	return copyOperation(e.operation), nil
+}
+
+func (s *Storage) removeExpired(now time.Time) {
+	for id, e := range s.operations {
+		if e.operation.Status.Done() && e.operation.UpdatedAt.Add(s.retention).Before(now) {
+			delete(s.operations, id)
+		}
+	}
+}
+
+func copyOperation(op model.Operation) model.Operation {
+	generators := make([]model.GeneratorProgress, len(op.Generators))
+	copy(generators, op.Generators)
+	op.Generators = generators
+
+	return op
+}
@@ -0,0 +1,120 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spirt-t/lg-operator/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitDone(t *testing.T, s *Storage, id string) model.Operation {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		op, err := s.Get(id)
+		assert.NoError(t, err)
+
+		if op.Status.Done() {
+			return op
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("operation is not finished")
+
+	return model.Operation{}
+}
+
+func TestStorage(t *testing.T) {
+	t.Run("succeeded", func(t *testing.T) {
+		s := NewStorage(time.Hour)
+
+		op := s.Start(2, func(_ context.Context, progress ProgressFunc) error {
+			progress(0, model.GeneratorProgress{Name: "lg-1", Stage: model.StageScheduled})
+			progress(0, model.GeneratorProgress{Stage: model.StageRunning})
+			progress(1, model.GeneratorProgress{Name: "lg-2", Stage: model.StageRunning})
+			return nil
+		})
+		assert.Equal(t, model.OperationRunning, op.Status)
+		assert.Equal(t, 2, len(op.Generators))
+		assert.Equal(t, model.StagePending, op.Generators[0].Stage)
+
+		op = waitDone(t, s, op.ID)
+		assert.Equal(t, model.OperationSucceeded, op.Status)
+		assert.Equal(t, "lg-1", op.Generators[0].Name)
+		assert.Equal(t, model.StageRunning, op.Generators[0].Stage)
+		assert.Equal(t, "lg-2", op.Generators[1].Name)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		s := NewStorage(time.Hour)
+
+		op := s.Start(1, func(_ context.Context, _ ProgressFunc) error {
+			return errors.New("some error")
+		})
+
+		op = waitDone(t, s, op.ID)
+		assert.Equal(t, model.OperationFailed, op.Status)
+		assert.Equal(t, "some error", op.Error)
+
+		_, err := s.Cancel(op.ID)
+		assert.True(t, errors.Is(err, ErrFinished))
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		s := NewStorage(time.Hour)
+
+		op := s.Start(1, func(ctx context.Context, _ ProgressFunc) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		op, err := s.Cancel(op.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, model.OperationCancelling, op.Status)
+
+		op = waitDone(t, s, op.ID)
+		assert.Equal(t, model.OperationCancelled, op.Status)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := NewStorage(time.Hour)
+
+		_, err := s.Get("unknown")
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		_, err = s.Cancel("unknown")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("list and retention", func(t *testing.T) {
+		s := NewStorage(0)
+
+		release := make(chan struct{})
+		running := s.Start(1, func(_ context.Context, _ ProgressFunc) error {
+			<-release
+			return nil
+		})
+		finished := s.Start(1, func(_ context.Context, _ ProgressFunc) error {
+			return nil
+		})
+
+		// finished operation expires immediately with zero retention
+		for i := 0; i < 100 && len(s.List()) > 1; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		list := s.List()
+		assert.Equal(t, 1, len(list))
+		assert.Equal(t, running.ID, list[0].ID)
+
+		_, err := s.Get(finished.ID)
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		close(release)
+	})
+}
@@ -25,14 +25,54 @@ const _ = grpc.SupportPackageIsVersion7
 type LoadGeneratorOperatorServiceClient interface {
 	// Debug entrypoint.
 	Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
-	// Create pod, service and ingress of load-generator according to the passed parameters.
+	// Create pod, service, ingress and config map of files of load-generator according to the passed parameters.
 	CreateGenerators(ctx context.Context, in *CreateGeneratorsRequest, opts ...grpc.CallOption) (*CreateGeneratorsResponse, error)
-	// Delete pod, service and ingress by load-generator name.
+	// Delete pod, service, ingress and config map by load-generator name.
 	DeleteGenerators(ctx context.Context, in *DeleteGeneratorsRequest, opts ...grpc.CallOption) (*DeleteGeneratorsResponse, error)
 	// Get list of all load-generators in cluster.
 	GeneratorsList(ctx context.Context, in *GeneratorsListRequest, opts ...grpc.CallOption) (*GeneratorsListResponse, error)
-	// Delete all pods, services and ingresses of generators. Use carefully!
-	ClearAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get load-generator by name with pod diagnostics and recent k8s events.
+	GetGenerator(ctx context.Context, in *GetGeneratorRequest, opts ...grpc.CallOption) (*GetGeneratorResponse, error)
+	// Watch changes of load-generators.
+	// Initial snapshot of existing generators is sent as ADDED events unless resource_version is set.
+	WatchGenerators(ctx context.Context, in *WatchGeneratorsRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_WatchGeneratorsClient, error)
+	// Stream logs of load-generator container line by line.
+	StreamGeneratorLogs(ctx context.Context, in *StreamGeneratorLogsRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_StreamGeneratorLogsClient, error)
+	// Get long-running operation of asynchronous generators creation.
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// Get list of long-running operations; the most recent first.
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// Cancel long-running operation and roll back generators created so far.
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
+	// Render pod, service and ingress of load-generators as they would be created, without creating them.
+	RenderGenerators(ctx context.Context, in *RenderGeneratorsRequest, opts ...grpc.CallOption) (*RenderGeneratorsResponse, error)
+	// Get list of templates of generator parameters defined in config.
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// Create generators of a new run; all of them are labeled with the run id.
+	CreateRun(ctx context.Context, in *CreateRunRequest, opts ...grpc.CallOption) (*CreateRunResponse, error)
+	// Get run with its generators and aggregate status.
+	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error)
+	// Get list of runs; the most recent first.
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	// Delete pods, services, ingresses and config maps of all generators of run; NOT_FOUND if the run has no generators.
+	DeleteRun(ctx context.Context, in *DeleteRunRequest, opts ...grpc.CallOption) (*DeleteRunResponse, error)
+	// Extend leases of generators from now; generators with lapsed lease are deleted by the operator.
+	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error)
+	// Hold leases of generators while the stream is open: the operator renews them itself
+	// and deletes the generators as soon as the stream is closed or broken.
+	HoldLease(ctx context.Context, opts ...grpc.CallOption) (LoadGeneratorOperatorService_HoldLeaseClient, error)
+	// Get list of artifact archives collected from generators before their deletion.
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
+	// Download gzipped tar of artifacts of generator in chunks.
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_DownloadArtifactClient, error)
+	// Upload ammo file to storage of the operator in chunks: the first message carries info of the file,
+	// the following ones carry its content. Returned id is referenced by creation parameters to mount the file.
+	UploadAmmo(ctx context.Context, opts ...grpc.CallOption) (LoadGeneratorOperatorService_UploadAmmoClient, error)
+	// Get quotas of teams from config and resources requested by their pending and running generators.
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error)
+	// Delete all pods, services, ingresses and config maps of generators of a namespace,
+	// or of all managed namespaces if explicitly requested. Artifacts of the generators are not collected. Use carefully!
+	ClearAll(ctx context.Context, in *ClearAllRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type loadGeneratorOperatorServiceClient struct {
@@ -79,7 +119,285 @@ func (c *loadGeneratorOperatorServiceClient) GeneratorsList(ctx context.Context,
 	return out, nil
 }
 
-func (c *loadGeneratorOperatorServiceClient) ClearAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *loadGeneratorOperatorServiceClient) GetGenerator(ctx context.Context, in *GetGeneratorRequest, opts ...grpc.CallOption) (*GetGeneratorResponse, error) {
+	out := new(GetGeneratorResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/GetGenerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) WatchGenerators(ctx context.Context, in *WatchGeneratorsRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_WatchGeneratorsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadGeneratorOperatorService_ServiceDesc.Streams[0], "/lg_operator.LoadGeneratorOperatorService/WatchGenerators", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadGeneratorOperatorServiceWatchGeneratorsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LoadGeneratorOperatorService_WatchGeneratorsClient interface {
+	Recv() (*WatchGeneratorsResponse, error)
+	grpc.ClientStream
+}
+
+type loadGeneratorOperatorServiceWatchGeneratorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadGeneratorOperatorServiceWatchGeneratorsClient) Recv() (*WatchGeneratorsResponse, error) {
+	m := new(WatchGeneratorsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) StreamGeneratorLogs(ctx context.Context, in *StreamGeneratorLogsRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_StreamGeneratorLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadGeneratorOperatorService_ServiceDesc.Streams[1], "/lg_operator.LoadGeneratorOperatorService/StreamGeneratorLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadGeneratorOperatorServiceStreamGeneratorLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LoadGeneratorOperatorService_StreamGeneratorLogsClient interface {
+	Recv() (*StreamGeneratorLogsResponse, error)
+	grpc.ClientStream
+}
+
+type loadGeneratorOperatorServiceStreamGeneratorLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadGeneratorOperatorServiceStreamGeneratorLogsClient) Recv() (*StreamGeneratorLogsResponse, error) {
+	m := new(StreamGeneratorLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error) {
+	out := new(CancelOperationResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) RenderGenerators(ctx context.Context, in *RenderGeneratorsRequest, opts ...grpc.CallOption) (*RenderGeneratorsResponse, error) {
+	out := new(RenderGeneratorsResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/RenderGenerators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) CreateRun(ctx context.Context, in *CreateRunRequest, opts ...grpc.CallOption) (*CreateRunResponse, error) {
+	out := new(CreateRunResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/CreateRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error) {
+	out := new(GetRunResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/GetRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
+	out := new(ListRunsResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/ListRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) DeleteRun(ctx context.Context, in *DeleteRunRequest, opts ...grpc.CallOption) (*DeleteRunResponse, error) {
+	out := new(DeleteRunResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/DeleteRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error) {
+	out := new(RenewLeaseResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) HoldLease(ctx context.Context, opts ...grpc.CallOption) (LoadGeneratorOperatorService_HoldLeaseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadGeneratorOperatorService_ServiceDesc.Streams[2], "/lg_operator.LoadGeneratorOperatorService/HoldLease", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadGeneratorOperatorServiceHoldLeaseClient{stream}
+	return x, nil
+}
+
+type LoadGeneratorOperatorService_HoldLeaseClient interface {
+	Send(*HoldLeaseRequest) error
+	Recv() (*HoldLeaseResponse, error)
+	grpc.ClientStream
+}
+
+type loadGeneratorOperatorServiceHoldLeaseClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadGeneratorOperatorServiceHoldLeaseClient) Send(m *HoldLeaseRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *loadGeneratorOperatorServiceHoldLeaseClient) Recv() (*HoldLeaseResponse, error) {
+	m := new(HoldLeaseResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error) {
+	out := new(ListArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/ListArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (LoadGeneratorOperatorService_DownloadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadGeneratorOperatorService_ServiceDesc.Streams[3], "/lg_operator.LoadGeneratorOperatorService/DownloadArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadGeneratorOperatorServiceDownloadArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LoadGeneratorOperatorService_DownloadArtifactClient interface {
+	Recv() (*DownloadArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type loadGeneratorOperatorServiceDownloadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadGeneratorOperatorServiceDownloadArtifactClient) Recv() (*DownloadArtifactResponse, error) {
+	m := new(DownloadArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) UploadAmmo(ctx context.Context, opts ...grpc.CallOption) (LoadGeneratorOperatorService_UploadAmmoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadGeneratorOperatorService_ServiceDesc.Streams[4], "/lg_operator.LoadGeneratorOperatorService/UploadAmmo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadGeneratorOperatorServiceUploadAmmoClient{stream}
+	return x, nil
+}
+
+type LoadGeneratorOperatorService_UploadAmmoClient interface {
+	Send(*UploadAmmoRequest) error
+	CloseAndRecv() (*UploadAmmoResponse, error)
+	grpc.ClientStream
+}
+
+type loadGeneratorOperatorServiceUploadAmmoClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadGeneratorOperatorServiceUploadAmmoClient) Send(m *UploadAmmoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *loadGeneratorOperatorServiceUploadAmmoClient) CloseAndRecv() (*UploadAmmoResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAmmoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error) {
+	out := new(GetQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/GetQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorOperatorServiceClient) ClearAll(ctx context.Context, in *ClearAllRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/lg_operator.LoadGeneratorOperatorService/ClearAll", in, out, opts...)
 	if err != nil {
@@ -94,14 +412,54 @@ func (c *loadGeneratorOperatorServiceClient) ClearAll(ctx context.Context, in *e
 type LoadGeneratorOperatorServiceServer interface {
 	// Debug entrypoint.
 	Hello(context.Context, *HelloRequest) (*HelloResponse, error)
-	// Create pod, service and ingress of load-generator according to the passed parameters.
+	// Create pod, service, ingress and config map of files of load-generator according to the passed parameters.
 	CreateGenerators(context.Context, *CreateGeneratorsRequest) (*CreateGeneratorsResponse, error)
-	// Delete pod, service and ingress by load-generator name.
+	// Delete pod, service, ingress and config map by load-generator name.
 	DeleteGenerators(context.Context, *DeleteGeneratorsRequest) (*DeleteGeneratorsResponse, error)
 	// Get list of all load-generators in cluster.
 	GeneratorsList(context.Context, *GeneratorsListRequest) (*GeneratorsListResponse, error)
-	// Delete all pods, services and ingresses of generators. Use carefully!
-	ClearAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Get load-generator by name with pod diagnostics and recent k8s events.
+	GetGenerator(context.Context, *GetGeneratorRequest) (*GetGeneratorResponse, error)
+	// Watch changes of load-generators.
+	// Initial snapshot of existing generators is sent as ADDED events unless resource_version is set.
+	WatchGenerators(*WatchGeneratorsRequest, LoadGeneratorOperatorService_WatchGeneratorsServer) error
+	// Stream logs of load-generator container line by line.
+	StreamGeneratorLogs(*StreamGeneratorLogsRequest, LoadGeneratorOperatorService_StreamGeneratorLogsServer) error
+	// Get long-running operation of asynchronous generators creation.
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// Get list of long-running operations; the most recent first.
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// Cancel long-running operation and roll back generators created so far.
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
+	// Render pod, service and ingress of load-generators as they would be created, without creating them.
+	RenderGenerators(context.Context, *RenderGeneratorsRequest) (*RenderGeneratorsResponse, error)
+	// Get list of templates of generator parameters defined in config.
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// Create generators of a new run; all of them are labeled with the run id.
+	CreateRun(context.Context, *CreateRunRequest) (*CreateRunResponse, error)
+	// Get run with its generators and aggregate status.
+	GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error)
+	// Get list of runs; the most recent first.
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	// Delete pods, services, ingresses and config maps of all generators of run; NOT_FOUND if the run has no generators.
+	DeleteRun(context.Context, *DeleteRunRequest) (*DeleteRunResponse, error)
+	// Extend leases of generators from now; generators with lapsed lease are deleted by the operator.
+	RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseResponse, error)
+	// Hold leases of generators while the stream is open: the operator renews them itself
+	// and deletes the generators as soon as the stream is closed or broken.
+	HoldLease(LoadGeneratorOperatorService_HoldLeaseServer) error
+	// Get list of artifact archives collected from generators before their deletion.
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// Download gzipped tar of artifacts of generator in chunks.
+	DownloadArtifact(*DownloadArtifactRequest, LoadGeneratorOperatorService_DownloadArtifactServer) error
+	// Upload ammo file to storage of the operator in chunks: the first message carries info of the file,
+	// the following ones carry its content. Returned id is referenced by creation parameters to mount the file.
+	UploadAmmo(LoadGeneratorOperatorService_UploadAmmoServer) error
+	// Get quotas of teams from config and resources requested by their pending and running generators.
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error)
+	// Delete all pods, services, ingresses and config maps of generators of a namespace,
+	// or of all managed namespaces if explicitly requested. Artifacts of the generators are not collected. Use carefully!
+	ClearAll(context.Context, *ClearAllRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedLoadGeneratorOperatorServiceServer()
 }
 
@@ -121,7 +479,61 @@ func (UnimplementedLoadGeneratorOperatorServiceServer) DeleteGenerators(context.
 func (UnimplementedLoadGeneratorOperatorServiceServer) GeneratorsList(context.Context, *GeneratorsListRequest) (*GeneratorsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratorsList not implemented")
 }
-func (UnimplementedLoadGeneratorOperatorServiceServer) ClearAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedLoadGeneratorOperatorServiceServer) GetGenerator(context.Context, *GetGeneratorRequest) (*GetGeneratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenerator not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) WatchGenerators(*WatchGeneratorsRequest, LoadGeneratorOperatorService_WatchGeneratorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGenerators not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) StreamGeneratorLogs(*StreamGeneratorLogsRequest, LoadGeneratorOperatorService_StreamGeneratorLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGeneratorLogs not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) RenderGenerators(context.Context, *RenderGeneratorsRequest) (*RenderGeneratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderGenerators not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) CreateRun(context.Context, *CreateRunRequest) (*CreateRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRun not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRun not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) DeleteRun(context.Context, *DeleteRunRequest) (*DeleteRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRun not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) HoldLease(LoadGeneratorOperatorService_HoldLeaseServer) error {
+	return status.Errorf(codes.Unimplemented, "method HoldLease not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) DownloadArtifact(*DownloadArtifactRequest, LoadGeneratorOperatorService_DownloadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) UploadAmmo(LoadGeneratorOperatorService_UploadAmmoServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAmmo not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedLoadGeneratorOperatorServiceServer) ClearAll(context.Context, *ClearAllRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAll not implemented")
 }
 func (UnimplementedLoadGeneratorOperatorServiceServer) mustEmbedUnimplementedLoadGeneratorOperatorServiceServer() {
@@ -210,8 +622,357 @@ func _LoadGeneratorOperatorService_GeneratorsList_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoadGeneratorOperatorService_GetGenerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeneratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).GetGenerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/GetGenerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).GetGenerator(ctx, req.(*GetGeneratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_WatchGenerators_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGeneratorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LoadGeneratorOperatorServiceServer).WatchGenerators(m, &loadGeneratorOperatorServiceWatchGeneratorsServer{stream})
+}
+
+type LoadGeneratorOperatorService_WatchGeneratorsServer interface {
+	Send(*WatchGeneratorsResponse) error
+	grpc.ServerStream
+}
+
+type loadGeneratorOperatorServiceWatchGeneratorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadGeneratorOperatorServiceWatchGeneratorsServer) Send(m *WatchGeneratorsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LoadGeneratorOperatorService_StreamGeneratorLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGeneratorLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LoadGeneratorOperatorServiceServer).StreamGeneratorLogs(m, &loadGeneratorOperatorServiceStreamGeneratorLogsServer{stream})
+}
+
+type LoadGeneratorOperatorService_StreamGeneratorLogsServer interface {
+	Send(*StreamGeneratorLogsResponse) error
+	grpc.ServerStream
+}
+
+type loadGeneratorOperatorServiceStreamGeneratorLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadGeneratorOperatorServiceStreamGeneratorLogsServer) Send(m *StreamGeneratorLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LoadGeneratorOperatorService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_RenderGenerators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderGeneratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).RenderGenerators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/RenderGenerators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).RenderGenerators(ctx, req.(*RenderGeneratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_CreateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).CreateRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/CreateRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).CreateRun(ctx, req.(*CreateRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).GetRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/GetRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).GetRun(ctx, req.(*GetRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).ListRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/ListRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).ListRuns(ctx, req.(*ListRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_DeleteRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).DeleteRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/DeleteRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).DeleteRun(ctx, req.(*DeleteRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).RenewLease(ctx, req.(*RenewLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_HoldLease_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LoadGeneratorOperatorServiceServer).HoldLease(&loadGeneratorOperatorServiceHoldLeaseServer{stream})
+}
+
+type LoadGeneratorOperatorService_HoldLeaseServer interface {
+	Send(*HoldLeaseResponse) error
+	Recv() (*HoldLeaseRequest, error)
+	grpc.ServerStream
+}
+
+type loadGeneratorOperatorServiceHoldLeaseServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadGeneratorOperatorServiceHoldLeaseServer) Send(m *HoldLeaseResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *loadGeneratorOperatorServiceHoldLeaseServer) Recv() (*HoldLeaseRequest, error) {
+	m := new(HoldLeaseRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LoadGeneratorOperatorService_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/ListArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGeneratorOperatorService_DownloadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LoadGeneratorOperatorServiceServer).DownloadArtifact(m, &loadGeneratorOperatorServiceDownloadArtifactServer{stream})
+}
+
+type LoadGeneratorOperatorService_DownloadArtifactServer interface {
+	Send(*DownloadArtifactResponse) error
+	grpc.ServerStream
+}
+
+type loadGeneratorOperatorServiceDownloadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadGeneratorOperatorServiceDownloadArtifactServer) Send(m *DownloadArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LoadGeneratorOperatorService_UploadAmmo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LoadGeneratorOperatorServiceServer).UploadAmmo(&loadGeneratorOperatorServiceUploadAmmoServer{stream})
+}
+
+type LoadGeneratorOperatorService_UploadAmmoServer interface {
+	SendAndClose(*UploadAmmoResponse) error
+	Recv() (*UploadAmmoRequest, error)
+	grpc.ServerStream
+}
+
+type loadGeneratorOperatorServiceUploadAmmoServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadGeneratorOperatorServiceUploadAmmoServer) SendAndClose(m *UploadAmmoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *loadGeneratorOperatorServiceUploadAmmoServer) Recv() (*UploadAmmoRequest, error) {
+	m := new(UploadAmmoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LoadGeneratorOperatorService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorOperatorServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lg_operator.LoadGeneratorOperatorService/GetQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorOperatorServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoadGeneratorOperatorService_ClearAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ClearAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -223,7 +984,7 @@ func _LoadGeneratorOperatorService_ClearAll_Handler(srv interface{}, ctx context
 		FullMethod: "/lg_operator.LoadGeneratorOperatorService/ClearAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LoadGeneratorOperatorServiceServer).ClearAll(ctx, req.(*emptypb.Empty))
+		return srv.(LoadGeneratorOperatorServiceServer).ClearAll(ctx, req.(*ClearAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -251,11 +1012,90 @@ var LoadGeneratorOperatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GeneratorsList",
 			Handler:    _LoadGeneratorOperatorService_GeneratorsList_Handler,
 		},
+		{
+			MethodName: "GetGenerator",
+			Handler:    _LoadGeneratorOperatorService_GetGenerator_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _LoadGeneratorOperatorService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _LoadGeneratorOperatorService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _LoadGeneratorOperatorService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "RenderGenerators",
+			Handler:    _LoadGeneratorOperatorService_RenderGenerators_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _LoadGeneratorOperatorService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateRun",
+			Handler:    _LoadGeneratorOperatorService_CreateRun_Handler,
+		},
+		{
+			MethodName: "GetRun",
+			Handler:    _LoadGeneratorOperatorService_GetRun_Handler,
+		},
+		{
+			MethodName: "ListRuns",
+			Handler:    _LoadGeneratorOperatorService_ListRuns_Handler,
+		},
+		{
+			MethodName: "DeleteRun",
+			Handler:    _LoadGeneratorOperatorService_DeleteRun_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _LoadGeneratorOperatorService_RenewLease_Handler,
+		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _LoadGeneratorOperatorService_ListArtifacts_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _LoadGeneratorOperatorService_GetQuotaUsage_Handler,
+		},
 		{
 			MethodName: "ClearAll",
 			Handler:    _LoadGeneratorOperatorService_ClearAll_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGenerators",
+			Handler:       _LoadGeneratorOperatorService_WatchGenerators_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGeneratorLogs",
+			Handler:       _LoadGeneratorOperatorService_StreamGeneratorLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "HoldLease",
+			Handler:       _LoadGeneratorOperatorService_HoldLease_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifact",
+			Handler:       _LoadGeneratorOperatorService_DownloadArtifact_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAmmo",
+			Handler:       _LoadGeneratorOperatorService_UploadAmmo_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "lg-operator/lg-operator.proto",
 }